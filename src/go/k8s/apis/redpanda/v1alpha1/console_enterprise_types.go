@@ -1,11 +1,25 @@
 package v1alpha1
 
-import corev1 "k8s.io/api/core/v1"
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
 
 // Enterprise defines configurable fields for features that require license
 type Enterprise struct {
 	// Console uses role-based access control (RBAC) to restrict system access to authorized users
 	RBAC EnterpriseRBAC `json:"rbac"`
+
+	// AuditLog configures logging of user requests for compliance
+	AuditLog *EnterpriseAuditLog `json:"auditLog,omitempty"`
+}
+
+// EnterpriseAuditLog defines configurable fields for Console audit logs
+type EnterpriseAuditLog struct {
+	Enabled bool `json:"enabled"`
+
+	// IncludeRequestBody logs the full request bodies, which can contain sensitive data
+	IncludeRequestBody bool `json:"includeRequestBody,omitempty"`
 }
 
 // EnterpriseRBAC defines configurable fields for specifying RBAC Authorization
@@ -15,6 +29,25 @@ type EnterpriseRBAC struct {
 	// RoleBindingsRef is the ConfigMap that contains the RBAC file
 	// The ConfigMap should contain "rbac.yaml" key
 	RoleBindingsRef corev1.LocalObjectReference `json:"roleBindingsRef"`
+
+	// EmailDomainBindings binds all users with email in the domain to a role
+	EmailDomainBindings []DomainRoleBinding `json:"emailDomainBindings,omitempty"`
+
+	// AllowedRoles restricts the roles that can be assigned to the listed roles
+	// If not set, all roles are allowed
+	AllowedRoles []string `json:"allowedRoles,omitempty"`
+
+	// CaseInsensitiveSubjects matches role binding subjects case-insensitively, e.g. emails in mixed case returned by the IdP
+	CaseInsensitiveSubjects bool `json:"caseInsensitiveSubjects,omitempty"`
+}
+
+// DomainRoleBinding binds users with email in Domain to RoleName
+type DomainRoleBinding struct {
+	// Domain is the email domain without "@", e.g. "corp.com"
+	Domain string `json:"domain"`
+
+	// RoleName is the name of the role defined in the RBAC file or a built-in role, e.g. "viewer"
+	RoleName string `json:"roleName"`
 }
 
 // EnterpriseLogin defines configurable fields to enable SSO Authentication for supported login providers
@@ -25,9 +58,101 @@ type EnterpriseLogin struct {
 	// If not provided, the default key is "jwt"
 	JWTSecretRef SecretKeyRef `json:"jwtSecretRef"`
 
+	// JWTRotation configures graceful rotation of the JWT signing secret
+	JWTRotation *EnterpriseLoginJWTRotation `json:"jwtRotation,omitempty"`
+
+	// +kubebuilder:validation:Pattern=`^/`
+	// CallbackPath is the path of the OAuth callback, e.g. "/console/auth/callbacks"
+	// Set it if Console is behind routing that rewrites paths, if not set, Console default is used
+	CallbackPath string `json:"callbackPath,omitempty"`
+
+	// Session configures the lifetime of login sessions
+	Session *EnterpriseLoginSession `json:"session,omitempty"`
+
 	Google *EnterpriseLoginGoogle `json:"google,omitempty"`
 
 	RedpandaCloud *EnterpriseLoginRedpandaCloud `json:"redpandaCloud,omitempty"`
+
+	// OIDC configures a generic OpenID Connect provider, e.g. a self-hosted identity provider
+	// RedpandaCloud and Google take precedence if these are also set
+	OIDC *EnterpriseLoginOIDC `json:"oidc,omitempty"`
+
+	// GitHub configures login with GitHub OAuth
+	// It is offered along with Google or OIDC, RedpandaCloud takes precedence if it is also set
+	GitHub *EnterpriseLoginGitHub `json:"github,omitempty"`
+}
+
+// EnterpriseLoginGitHub defines configurable fields for GitHub OAuth
+type EnterpriseLoginGitHub struct {
+	Enabled bool `json:"enabled"`
+
+	// ClientCredentials is the Secret that contains SSO credentials
+	// The Secret should contain keys "clientId", "clientSecret"
+	ClientCredentialsRef NamespaceNameRef `json:"clientCredentialsRef"`
+
+	// OrganizationsRef is the ConfigMap that lists the GitHub organizations allowed to log in
+	// The ConfigMap should contain "organizations" key with one organization per line
+	// If not set, members of any organization can log in
+	OrganizationsRef *corev1.LocalObjectReference `json:"organizationsRef,omitempty"`
+
+	// Teams lists the GitHub teams allowed to log in, as "<organization>/<team-slug>", e.g. "redpanda-data/core"
+	// If set, only members of the teams can log in
+	Teams []string `json:"teams,omitempty"`
+}
+
+// IsGitHubLoginEnabled returns true if GitHub OAuth provider is enabled
+func (c *Console) IsGitHubLoginEnabled() bool {
+	login := c.Spec.Login
+	return login != nil && login.GitHub != nil && login.GitHub.Enabled
+}
+
+// EnterpriseLoginOIDC defines configurable fields for a generic OIDC provider
+type EnterpriseLoginOIDC struct {
+	Enabled bool `json:"enabled"`
+
+	// +kubebuilder:validation:Pattern=`^https?://`
+	// Issuer is the URL of the OIDC issuer, e.g. "https://auth.corp.com/realms/console"
+	// The discovery document is served at "<issuer>/.well-known/openid-configuration"
+	Issuer string `json:"issuer"`
+
+	// ClientCredentials is the Secret that contains SSO credentials
+	// The Secret should contain keys "clientId", "clientSecret"
+	ClientCredentialsRef NamespaceNameRef `json:"clientCredentialsRef"`
+
+	// Scopes are requested from the provider, if not set, Console default OIDC scopes are used
+	Scopes []string `json:"scopes,omitempty"`
+
+	// DisplayName is the name of the provider shown on the login page, e.g. "Okta"
+	DisplayName string `json:"displayName,omitempty"`
+}
+
+// IsOIDCLoginEnabled returns true if the generic OIDC provider is enabled
+func (c *Console) IsOIDCLoginEnabled() bool {
+	login := c.Spec.Login
+	return login != nil && login.OIDC != nil && login.OIDC.Enabled
+}
+
+// EnterpriseLoginJWTRotation defines configurable fields for JWT signing secret rotation
+type EnterpriseLoginJWTRotation struct {
+	// AllowPreviousSecretRef is the Secret that contains the previous JWT signing secret
+	// Tokens signed with the previous secret are accepted during rotation
+	// If not provided, the default key is "jwt"
+	AllowPreviousSecretRef *SecretKeyRef `json:"allowPreviousSecretRef,omitempty"`
+}
+
+// EnterpriseLoginSession defines configurable fields for login sessions
+type EnterpriseLoginSession struct {
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Format=duration
+	// Duration is the maximum lifetime of a session, e.g. "12h"
+	// If not set, Console default is used
+	Duration *metav1.Duration `json:"duration,omitempty"`
+
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Format=duration
+	// IdleTimeout ends sessions without requests for the duration, e.g. "30m"
+	// Must not be longer than Duration, if not set, idle sessions are kept until they expire
+	IdleTimeout *metav1.Duration `json:"idleTimeout,omitempty"`
 }
 
 // EnterpriseLoginRedpandaCloud defines configurable fields for RedpandaCloud SSO provider
@@ -42,6 +167,19 @@ type EnterpriseLoginRedpandaCloud struct {
 
 	// AllowedOrigins indicates if response is allowed from given origin
 	AllowedOrigins string `json:"allowedOrigins,omitempty" yaml:"allowedOrigins,omitempty"`
+
+	// AdditionalScopes are requested from the auth server in addition to the default OIDC scopes, e.g. "groups"
+	AdditionalScopes []string `json:"additionalScopes,omitempty" yaml:"additionalScopes,omitempty"`
+
+	// RequireMFAClaim is the ID token claim that must be present, e.g. "amr"
+	// Console rejects sessions without the claim
+	RequireMFAClaim string `json:"requireMfaClaim,omitempty" yaml:"requireMfaClaim,omitempty"`
+
+	// UsePKCE enforces Proof Key for Code Exchange in the OAuth authorization code flow
+	UsePKCE bool `json:"usePkce,omitempty" yaml:"usePkce,omitempty"`
+
+	// ClaimMappings maps standard claims to the claim names used by the auth server, e.g. "email": "upn"
+	ClaimMappings map[string]string `json:"claimMappings,omitempty" yaml:"claimMappings,omitempty"`
 }
 
 // IsGoogleLoginEnabled returns true if Google SSO provider is enabled
@@ -60,6 +198,19 @@ type EnterpriseLoginGoogle struct {
 
 	// Use Google groups in your RBAC role bindings.
 	Directory *EnterpriseLoginGoogleDirectory `json:"directory,omitempty"`
+
+	// AdditionalScopes are requested from Google in addition to the default OIDC scopes
+	AdditionalScopes []string `json:"additionalScopes,omitempty"`
+
+	// RequireMFAClaim is the ID token claim that must be present, e.g. "amr"
+	// Console rejects sessions without the claim
+	RequireMFAClaim string `json:"requireMfaClaim,omitempty"`
+
+	// UsePKCE enforces Proof Key for Code Exchange in the OAuth authorization code flow
+	UsePKCE bool `json:"usePkce,omitempty"`
+
+	// ClaimMappings maps standard claims to the claim names used by Google, e.g. "name": "given_name"
+	ClaimMappings map[string]string `json:"claimMappings,omitempty"`
 }
 
 // EnterpriseLoginGoogleDirectory defines configurable fields for enabling RBAC Google groups sync
@@ -70,4 +221,10 @@ type EnterpriseLoginGoogleDirectory struct {
 
 	// TargetPrincipal is the user that shall be impersonated by the service account
 	TargetPrincipal string `json:"targetPrincipal"`
+
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Format=duration
+	// RefreshInterval is the interval to sync group memberships from the directory
+	// If not set, Console default is used
+	RefreshInterval *metav1.Duration `json:"refreshInterval,omitempty"`
 }
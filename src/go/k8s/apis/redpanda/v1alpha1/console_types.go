@@ -10,6 +10,8 @@
 package v1alpha1
 
 import (
+	"time"
+
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -40,18 +42,42 @@ type ConsoleSpec struct {
 	Deployment Deployment `json:"deployment"`
 	Connect    Connect    `json:"connect"`
 
+	// +optional
+	Kafka Kafka `json:"kafka"`
+
+	// +optional
+	Console ConsoleSettings `json:"console"`
+
 	Enterprise *Enterprise `json:"enterprise,omitempty"`
 
 	// If you don't provide an enterprise license, Console ignores configurations for enterprise features
 	// REF https://docs.redpanda.com/docs/console/reference/config/
 	// If key is not provided in the SecretRef, Secret data should have key "license"
-	LicenseRef *SecretKeyRef `json:"licenseRef,omitempty"`
+	LicenseRef *LicenseSecretKeyRef `json:"licenseRef,omitempty"`
+
+	// LicenseOffline indicates LicenseRef is an offline license for air-gapped environments
+	// The license is rendered as is and the LicenseOffline condition is set
+	LicenseOffline bool `json:"licenseOffline,omitempty"`
 
 	// Login contains all configurations in order to protect Console with a login screen
 	// Configure one or more of the below identity providers in order to support SSO
 	// This feature requires an Enterprise license
 	// REF https://docs.redpanda.com/docs/console/single-sign-on/identity-providers/google/
 	Login *EnterpriseLogin `json:"login,omitempty"`
+
+	// DisableOwnerReferences omits owner references on resources generated for Console
+	// Useful if owner references conflict with GitOps tools, e.g. ArgoCD
+	// Generated resources are deleted using labels via finalizer instead of garbage collection
+	DisableOwnerReferences bool `json:"disableOwnerReferences,omitempty"`
+
+	// HelmRelease stamps Helm release annotations on resources generated for Console
+	// Useful to let Helm adopt the resources when migrating to the Console Helm chart
+	HelmRelease *HelmRelease `json:"helmRelease,omitempty"`
+
+	// ConfigTemplateRef is the ConfigMap that contains a Go template of the Console config
+	// The ConfigMap should contain "config.yaml.tmpl" key
+	// The template is executed with the generated config, including resolved brokers and secrets, and replaces it
+	ConfigTemplateRef *corev1.LocalObjectReference `json:"configTemplateRef,omitempty"`
 }
 
 // Server is the Console app HTTP server config
@@ -88,6 +114,8 @@ type Server struct {
 	// Idle timeout for HTTP server
 	HTTPServerIdleTimeout *metav1.Duration `json:"idleTimeout,omitempty"`
 
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=9
 	// +kubebuilder:default=4
 	// Compression level applied to all http responses. Valid values are: 0-9 (0=completely disable compression middleware, 1=weakest compression, 9=best compression)
 	CompressionLevel int `json:"compressionLevel,omitempty"`
@@ -102,11 +130,193 @@ type Server struct {
 	// +kubebuilder:default=true
 	// If a base-path is set (either by the 'base-path' setting, or by the 'X-Forwarded-Prefix' header), they will be removed from the request url. You probably want to leave this enabled, unless you are using a proxy that can remove the prefix automatically (like Traefik's 'StripPrefix' option)
 	StripPrefix bool `json:"stripPrefix,omitempty"`
+
+	// APIBasePath is the path prefix of the Console API, e.g. "api/", independent of the UI base path
+	// Use it when the API and UI are routed separately by a proxy in front of Console
+	APIBasePath string `json:"apiBasePath,omitempty"`
+
+	// MaintenanceMode shows a maintenance page, e.g. during upgrades
+	MaintenanceMode bool `json:"maintenanceMode,omitempty"`
+
+	// MaintenanceMessage is the message shown on the maintenance page
+	MaintenanceMessage string `json:"maintenanceMessage,omitempty"`
+
+	// +optional
+	UI ServerUI `json:"ui"`
+
+	// TLS serves Console over HTTPS
+	TLS *ServerTLS `json:"tls,omitempty"`
+
+	// +kubebuilder:validation:Enum=ClusterIP;NodePort;LoadBalancer
+	// +kubebuilder:default=ClusterIP
+	// ServiceType is the type of the Console Service
+	ServiceType corev1.ServiceType `json:"serviceType,omitempty"`
+
+	// +kubebuilder:validation:Enum=Cluster;Local
+	// ExternalTrafficPolicy of the Console Service, set "Local" to preserve the client source IP
+	// Only applies to NodePort and LoadBalancer Service types
+	ExternalTrafficPolicy corev1.ServiceExternalTrafficPolicyType `json:"externalTrafficPolicy,omitempty"`
+
+	// CreateServiceWhenReady creates the Console Service only after the Deployment is available
+	// Use it to avoid failing load balancer health checks during the initial rollout
+	// Once created, the Service is kept even if the Deployment becomes unavailable
+	CreateServiceWhenReady bool `json:"createServiceWhenReady,omitempty"`
+
+	// Headless creates the Console Service without a cluster IP, its DNS name resolves to the pod IPs
+	// Use it to address Console pods directly, ServiceType is ignored and the Service is recreated when toggled
+	Headless bool `json:"headless,omitempty"`
+
+	// KafkaAwareReadiness adds a readiness probe on the Console endpoint that checks the Kafka connection
+	// Pods are not ready while Console can't connect to Kafka
+	KafkaAwareReadiness bool `json:"kafkaAwareReadiness,omitempty"`
+
+	// +kubebuilder:validation:Minimum=0
+	// TrustedProxyHops is the number of reverse proxies in front of Console
+	// Console uses it to extract the client IP from the X-Forwarded-For header
+	TrustedProxyHops int `json:"trustedProxyHops,omitempty"`
+
+	// RequestIDHeader is the header Console reads the request ID from and propagates, e.g. "X-Request-ID"
+	// Useful to correlate Console logs with traces of proxies in front of Console
+	RequestIDHeader string `json:"requestIdHeader,omitempty"`
+
+	// RequestID configures Console to generate request IDs and propagate them, e.g. for tracing
+	RequestID *ServerRequestID `json:"requestId,omitempty"`
+
+	// MetricsAuth protects the Prometheus metrics endpoint with basic auth
+	MetricsAuth *ServerMetricsAuth `json:"metricsAuth,omitempty"`
+
+	// AccessLog configures the access log of the Console server
+	AccessLog *ServerAccessLog `json:"accessLog,omitempty"`
+}
+
+// ServerAccessLog defines configurable fields for the access log
+type ServerAccessLog struct {
+	// +kubebuilder:validation:Pattern=`^(0(\.[0-9]+)?|1(\.0+)?)$`
+	// SampleRate is the fraction of requests that are logged, between 0 and 1, e.g. "0.1" logs every tenth request
+	// If not set, all requests are logged
+	// It is a string on purpose, floats are avoided in the CRD because they don't round-trip reliably across clients
+	// The pattern restricts it to decimals between 0 and 1, the operator rejects values out of range
+	SampleRate string `json:"sampleRate,omitempty"`
+}
+
+// ServerRequestID defines configurable fields for request IDs
+type ServerRequestID struct {
+	// Enabled generates a request ID for requests without one, the ID is logged and returned in the response
+	Enabled bool `json:"enabled"`
+
+	// HeaderName is the header of the request ID, e.g. "X-Request-ID"
+	// If not set, RequestIDHeader is used
+	HeaderName string `json:"headerName,omitempty"`
+}
+
+// ServerMetricsAuth defines the basic auth credentials of the metrics endpoint
+type ServerMetricsAuth struct {
+	// BasicAuthRef is the Secret that contains the basic auth credentials
+	// The Secret should contain keys "username", "password"
+	BasicAuthRef NamespaceNameRef `json:"basicAuthRef"`
+}
+
+// ServerTLS defines TLS certificates for the Console server
+type ServerTLS struct {
+	Enabled bool `json:"enabled"`
+
+	// SecretRef is the Secret in the Console namespace that contains the server certificate
+	// The Secret should contain keys "tls.crt", "tls.key"
+	SecretRef corev1.LocalObjectReference `json:"secretRef"`
+
+	// ClientAuth requires clients to present certificates signed by the referenced CA
+	ClientAuth *ServerTLSClientAuth `json:"clientAuth,omitempty"`
+
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Format=duration
+	// ReloadInterval is how often Console reloads the server certificate from disk
+	// Useful if certificates are rotated frequently, if not set, Console default is used
+	ReloadInterval *metav1.Duration `json:"reloadInterval,omitempty"`
+}
+
+// ServerTLSClientAuth defines client certificate authentication for the Console server
+type ServerTLSClientAuth struct {
+	// +kubebuilder:default=require-and-verify
+	Mode ServerTLSClientAuthMode `json:"mode,omitempty"`
+
+	// CARef is the Secret in the Console namespace that contains the CA to verify client certificates
+	// The Secret should contain key "ca.crt"
+	CARef corev1.LocalObjectReference `json:"caRef"`
+}
+
+// ServerTLSClientAuthMode is the policy for client certificates
+// +kubebuilder:validation:Enum=request;require;verify-if-given;require-and-verify
+type ServerTLSClientAuthMode string
+
+const (
+	// ServerTLSClientAuthRequest requests a client certificate but does not require it
+	ServerTLSClientAuthRequest ServerTLSClientAuthMode = "request"
+	// ServerTLSClientAuthRequire requires a client certificate but does not verify it
+	ServerTLSClientAuthRequire ServerTLSClientAuthMode = "require"
+	// ServerTLSClientAuthVerifyIfGiven verifies a client certificate if it is presented
+	ServerTLSClientAuthVerifyIfGiven ServerTLSClientAuthMode = "verify-if-given"
+	// ServerTLSClientAuthRequireAndVerify requires and verifies a client certificate
+	ServerTLSClientAuthRequireAndVerify ServerTLSClientAuthMode = "require-and-verify"
+)
+
+// IsServerTLSEnabled returns true if Console is served over HTTPS
+func (c *Console) IsServerTLSEnabled() bool {
+	return c.Spec.Server.TLS != nil && c.Spec.Server.TLS.Enabled
+}
+
+// IsServerTLSClientAuthEnabled returns true if Console requires client certificates
+func (c *Console) IsServerTLSClientAuthEnabled() bool {
+	return c.IsServerTLSEnabled() && c.Spec.Server.TLS.ClientAuth != nil
+}
+
+// ServerUI defines configurable fields for the Console frontend
+type ServerUI struct {
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Format=duration
+	// RefreshInterval is the poll interval of live views in the frontend
+	// If not set, Console default is used
+	RefreshInterval *metav1.Duration `json:"refreshInterval,omitempty"`
 }
 
 // Schema defines configurable fields for Schema Registry
 type Schema struct {
 	Enabled bool `json:"enabled"`
+
+	// AllowSubjectDeletion allows deleting Schema Registry subjects from Console
+	AllowSubjectDeletion bool `json:"allowSubjectDeletion,omitempty"`
+
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Format=duration
+	// RequestTimeout is the timeout of requests to Schema Registry, e.g. "10s"
+	// If not set, Console default is used
+	RequestTimeout *metav1.Duration `json:"requestTimeout,omitempty"`
+
+	// +kubebuilder:validation:Minimum=1
+	// PaginationSize is the number of subjects Console requests per page when listing subjects
+	// Lower it if listing subjects of a large Schema Registry times out, if not set, Console default is used
+	PaginationSize int `json:"paginationSize,omitempty"`
+
+	// BasicAuthRef is the Secret that contains Schema Registry basic auth credentials
+	// Expects to have keys "username", "password"
+	BasicAuthRef *SchemaBasicAuthRef `json:"basicAuthRef,omitempty"`
+
+	// Username is the Schema Registry basic auth username, the password is referenced by PasswordRef
+	// Ignored if BasicAuthRef is set
+	Username string `json:"username,omitempty"`
+
+	// PasswordRef is the Secret that contains the Schema Registry basic auth password
+	// If key is not provided in the SecretRef, Secret data should have key "password"
+	PasswordRef *SecretKeyRef `json:"passwordRef,omitempty"`
+}
+
+// SchemaBasicAuthRef references the Secret that contains Schema Registry credentials
+type SchemaBasicAuthRef struct {
+	// Name of the Secret
+	Name string `json:"name"`
+
+	// Namespace of the Secret, e.g. a namespace that contains credentials shared by teams
+	// If not set, the Console namespace is used
+	Namespace string `json:"namespace,omitempty"`
 }
 
 // Deployment defines configurable fields for the Console Deployment resource
@@ -121,8 +331,528 @@ type Deployment struct {
 
 	// +kubebuilder:default=1
 	MaxSurge int32 `json:"maxSurge,omitempty"`
+
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Format=duration
+	// +kubebuilder:default="5m"
+	// Duration the Deployment is allowed to have less available replicas than desired before MinReplicasUnavailable condition is set
+	ReplicasUnavailableGracePeriod *metav1.Duration `json:"replicasUnavailableGracePeriod,omitempty"`
+
+	// Service mesh preset that sets sidecar injection annotations on Console pods
+	// Kafka ports are excluded from mesh interception
+	ServiceMesh *ServiceMesh `json:"serviceMesh,omitempty"`
+
+	// Autoscaling creates a HorizontalPodAutoscaler for the Deployment
+	// If enabled, Replicas is ignored
+	Autoscaling *Autoscaling `json:"autoscaling,omitempty"`
+
+	// SecurityContext of the Console container
+	// If ReadOnlyRootFilesystem is set, writable emptyDir volumes are mounted for /tmp and the Console cache directory
+	SecurityContext *corev1.SecurityContext `json:"securityContext,omitempty"`
+
+	// HostNetwork runs Console pods in the host network namespace
+	// If enabled, dnsPolicy is set to ClusterFirstWithHostNet so cluster DNS names are still resolved
+	HostNetwork bool `json:"hostNetwork,omitempty"`
+
+	// ConfigInSecret renders the Console config into a Secret instead of a ConfigMap
+	// Use it if the config contains sensitive values, e.g. the license or the JWT signing secret
+	ConfigInSecret bool `json:"configInSecret,omitempty"`
+
+	// +kubebuilder:validation:Enum=File;FallbackToLogsOnError
+	// TerminationMessagePolicy of the Console container
+	// Use FallbackToLogsOnError to report the last log lines of a crashed container in its status
+	TerminationMessagePolicy corev1.TerminationMessagePolicy `json:"terminationMessagePolicy,omitempty"`
+
+	// ImagePullSecrets of Console pods
+	// Pull secrets added to the Console ServiceAccount are kept and merged into the pod without duplicates
+	// If not set, pods use the pull secrets of the ServiceAccount
+	ImagePullSecrets []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
+
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Format=duration
+	// DrainTimeout delays the shutdown of Console pods with a preStop hook, e.g. to leave consumer groups cleanly
+	// The termination grace period is extended by DrainTimeout so Server.ServerGracefulShutdownTimeout is kept
+	DrainTimeout *metav1.Duration `json:"drainTimeout,omitempty"`
+
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:validation:Type=array
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// InitContainers run in order before the Console container, e.g. to wait for dependencies
+	// The containers are validated by the API server when the Deployment is updated
+	InitContainers []corev1.Container `json:"initContainers,omitempty"`
+
+	// Metrics configures the Prometheus metrics endpoint of Console
+	// Console pods are annotated with prometheus.io scrape annotations that use the same path
+	Metrics *DeploymentMetrics `json:"metrics,omitempty"`
+
+	// AdoptOverlay sets Console as owner of the ConfigTemplateRef ConfigMap, so it is garbage collected with Console
+	// The ConfigMap is created by the user and is not owned by Console otherwise, ignored if DisableOwnerReferences is set
+	AdoptOverlay bool `json:"adoptOverlay,omitempty"`
+
+	// ReadinessGates are extra conditions evaluated for pod readiness, e.g. to order the rollout with an external controller
+	// A pod is ready only when all its containers are ready and the gate conditions are "True"
+	ReadinessGates []corev1.PodReadinessGate `json:"readinessGates,omitempty"`
+}
+
+// DeploymentMetrics defines the Prometheus metrics endpoint of Console
+type DeploymentMetrics struct {
+	// +kubebuilder:validation:Pattern=`^/`
+	// Path of the metrics endpoint, e.g. "/internal/metrics"
+	// If not set, Console serves metrics on "/admin/metrics"
+	Path string `json:"path,omitempty"`
 }
 
+// DefaultReplicasUnavailableGracePeriod is the grace period used if ReplicasUnavailableGracePeriod is not set
+const DefaultReplicasUnavailableGracePeriod = 5 * time.Minute
+
+// GetReplicasUnavailableGracePeriod returns the grace period before MinReplicasUnavailable condition is set
+// Falls back to DefaultReplicasUnavailableGracePeriod if not set, e.g. Consoles created before the field was added
+func (c *Console) GetReplicasUnavailableGracePeriod() time.Duration {
+	if p := c.Spec.Deployment.ReplicasUnavailableGracePeriod; p != nil {
+		return p.Duration
+	}
+	return DefaultReplicasUnavailableGracePeriod
+}
+
+// IsReadOnlyRootFilesystem returns true if the Console container root filesystem is read-only
+func (c *Console) IsReadOnlyRootFilesystem() bool {
+	sc := c.Spec.Deployment.SecurityContext
+	return sc != nil && sc.ReadOnlyRootFilesystem != nil && *sc.ReadOnlyRootFilesystem
+}
+
+// Autoscaling defines configurable fields for the HorizontalPodAutoscaler
+type Autoscaling struct {
+	Enabled bool `json:"enabled"`
+
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default=1
+	MinReplicas int32 `json:"minReplicas,omitempty"`
+
+	// +kubebuilder:validation:Minimum=1
+	MaxReplicas int32 `json:"maxReplicas"`
+
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:default=80
+	// TargetCPUUtilization is the target average CPU utilization in percent of requested CPU
+	TargetCPUUtilization int32 `json:"targetCPUUtilization,omitempty"`
+}
+
+// IsAutoscalingEnabled returns true if the Deployment is scaled by a HorizontalPodAutoscaler
+func (c *Console) IsAutoscalingEnabled() bool {
+	a := c.Spec.Deployment.Autoscaling
+	return a != nil && a.Enabled
+}
+
+// ServiceMesh defines the service mesh Console pods are injected into
+type ServiceMesh struct {
+	Provider ServiceMeshProvider `json:"provider"`
+}
+
+// ServiceMeshProvider is the type of service mesh
+// +kubebuilder:validation:Enum=istio;linkerd
+type ServiceMeshProvider string
+
+const (
+	// ServiceMeshIstio injects Console pods with Istio sidecar
+	ServiceMeshIstio ServiceMeshProvider = "istio"
+	// ServiceMeshLinkerd injects Console pods with Linkerd proxy
+	ServiceMeshLinkerd ServiceMeshProvider = "linkerd"
+)
+
+// HelmRelease defines the Helm release that adopts resources generated for Console
+type HelmRelease struct {
+	// Name is the name of the Helm release
+	Name string `json:"name"`
+
+	// Namespace is the namespace of the Helm release
+	// If not set, the Console namespace is used
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// ConsoleSettings defines configurable fields for the Console UI
+type ConsoleSettings struct {
+	// +kubebuilder:validation:Minimum=1
+	// MaxMessagesPerFetch is the maximum number of messages fetched per request in the UI
+	// If not set, Console default is used
+	MaxMessagesPerFetch int `json:"maxMessagesPerFetch,omitempty"`
+
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Format=duration
+	// StatsRefreshInterval is how often topic sizes and partition counts are refreshed, e.g. "1m"
+	// Increase on large clusters where collecting stats is expensive, if not set, Console default is used
+	StatsRefreshInterval *metav1.Duration `json:"statsRefreshInterval,omitempty"`
+
+	// Branding customizes the Console UI, e.g. to distinguish environments
+	Branding *ConsoleBranding `json:"branding,omitempty"`
+
+	// DisableTelemetry opts out of the analytics collected by the Console frontend
+	DisableTelemetry bool `json:"disableTelemetry,omitempty"`
+}
+
+// ConsoleBranding defines configurable fields for the Console UI branding
+type ConsoleBranding struct {
+	// EnvironmentLabel is shown as a banner in the Console UI, e.g. "production"
+	EnvironmentLabel string `json:"environmentLabel,omitempty"`
+}
+
+// Kafka defines configurable fields for the Kafka client used by Console
+type Kafka struct {
+	// SRVRecord is the DNS SRV record resolved to the list of brokers, e.g. "_kafka._tcp.example.com"
+	// If set, the resolved brokers are used instead of the brokers of the referenced Cluster
+	SRVRecord string `json:"srvRecord,omitempty"`
+
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Format=duration
+	// +kubebuilder:default="5m"
+	// Interval to resolve SRVRecord again to pick up broker changes
+	SRVRefreshInterval *metav1.Duration `json:"srvRefreshInterval,omitempty"`
+
+	Producer *KafkaProducer `json:"producer,omitempty"`
+
+	Consumer *KafkaConsumer `json:"consumer,omitempty"`
+
+	// SASL uses existing credentials to connect to Kafka, e.g. of an external cluster
+	// If set, the operator does not create a SCRAM user and ACLs for Console in the referenced Cluster
+	SASL *KafkaSASL `json:"sasl,omitempty"`
+
+	// RequestTimeoutOverrides overrides the request timeout per Kafka API
+	// The key is the Kafka API name, e.g. "DeleteRecords", the value is a duration, e.g. "30s"
+	RequestTimeoutOverrides map[string]string `json:"requestTimeoutOverrides,omitempty"`
+
+	// Proxy configures Console to dial Kafka brokers through a SOCKS5 proxy
+	Proxy *KafkaProxy `json:"proxy,omitempty"`
+
+	// +kubebuilder:validation:Pattern=`^https?://`
+	// HTTPProxyURL is the URL of the HTTP proxy used by the Kafka client dialer, e.g. "http://proxy.example.com:3128"
+	// The proxy must support the HTTP CONNECT method
+	HTTPProxyURL string `json:"httpProxyUrl,omitempty"`
+
+	// +kubebuilder:validation:Minimum=1
+	// MaxPollRecords is the maximum number of records returned by a single poll when previewing messages
+	// If not set, Console default is used
+	MaxPollRecords int `json:"maxPollRecords,omitempty"`
+
+	// SupportedCompressionCodecs are the compression codecs Console can decode, e.g. "zstd"
+	// If not set, Console default is used
+	SupportedCompressionCodecs []KafkaCompressionCodec `json:"supportedCompressionCodecs,omitempty"`
+
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Format=duration
+	// ConnectionMaxIdle is the duration after which idle broker connections are closed
+	// If not set, Console default is used
+	ConnectionMaxIdle *metav1.Duration `json:"connectionMaxIdle,omitempty"`
+
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Format=duration
+	// BrokerTimeout is the timeout of a single broker connection, e.g. dialing or waiting for a response
+	// Unlike RequestTimeoutOverrides, it does not bound retries of a request, if not set, Console default is used
+	BrokerTimeout *metav1.Duration `json:"brokerTimeout,omitempty"`
+
+	// +kubebuilder:validation:Minimum=0
+	// Retries is the number of times a request is retried on transient broker errors, 0 disables retries
+	// If not set, Console default is used
+	Retries *int `json:"retries,omitempty"`
+
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Format=duration
+	// RetryBackoff is the duration to wait between retries of a request
+	// If not set, Console default is used
+	RetryBackoff *metav1.Duration `json:"retryBackoff,omitempty"`
+
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Format=duration
+	// SessionTimeout is the consumer group session timeout, the member is removed if no heartbeat is received
+	// If not set, Console default is used
+	SessionTimeout *metav1.Duration `json:"sessionTimeout,omitempty"`
+
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Format=duration
+	// HeartbeatInterval is the interval of consumer group heartbeats, must be shorter than SessionTimeout
+	// If not set, Console default is used
+	HeartbeatInterval *metav1.Duration `json:"heartbeatInterval,omitempty"`
+
+	// ConsumerGroupPrefix scopes the consumer group ACLs of the Console SASL user to groups with the prefix
+	// If not set, the SASL user created by the operator can access all consumer groups
+	ConsumerGroupPrefix string `json:"consumerGroupPrefix,omitempty"`
+
+	// Protobuf configures Console to deserialize Protobuf records with proto files from a ConfigMap
+	Protobuf *KafkaProtobuf `json:"protobuf,omitempty"`
+
+	// TLS connects Console to Kafka brokers over TLS
+	TLS *KafkaTLS `json:"tls,omitempty"`
+}
+
+// KafkaTLS defines the TLS config of the Kafka client
+type KafkaTLS struct {
+	// UseClusterCA verifies brokers with the CA of the Kafka API certificate of the referenced Cluster
+	// The CA is read from the node certificate Secret of the Cluster, Console must be in the Cluster namespace
+	UseClusterCA bool `json:"useClusterCa,omitempty"`
+
+	// CARef is the Secret in the Console namespace that contains the CA of the brokers, ignored if UseClusterCA is set
+	// The Secret should contain key "ca.crt", if not set, the system CAs are used
+	CARef *corev1.LocalObjectReference `json:"caRef,omitempty"`
+
+	// Renegotiation allows brokers to request TLS renegotiation, Go disables it by default
+	// If not set, renegotiation is never allowed
+	Renegotiation KafkaTLSRenegotiation `json:"renegotiation,omitempty"`
+}
+
+// KafkaTLSRenegotiation is the TLS renegotiation support of the Kafka client
+// +kubebuilder:validation:Enum=never;once;freely
+type KafkaTLSRenegotiation string
+
+const (
+	// KafkaTLSRenegotiationNever disables renegotiation
+	KafkaTLSRenegotiationNever KafkaTLSRenegotiation = "never"
+	// KafkaTLSRenegotiationOnce allows a broker to renegotiate once per connection
+	KafkaTLSRenegotiationOnce KafkaTLSRenegotiation = "once"
+	// KafkaTLSRenegotiationFreely allows a broker to renegotiate repeatedly
+	KafkaTLSRenegotiationFreely KafkaTLSRenegotiation = "freely"
+)
+
+// KafkaProtobuf defines configurable fields for Protobuf deserialization
+type KafkaProtobuf struct {
+	// DescriptorConfigMapRef is the ConfigMap that contains the proto files, e.g. key "orders.proto"
+	// The ConfigMap is mounted as files instead of being inlined in the Console config
+	DescriptorConfigMapRef corev1.LocalObjectReference `json:"descriptorConfigMapRef"`
+
+	// Mappings define the proto types used to deserialize records of each topic
+	Mappings []KafkaProtobufTopicMapping `json:"mappings,omitempty"`
+}
+
+// KafkaProtobufTopicMapping defines the proto types of a topic
+type KafkaProtobufTopicMapping struct {
+	TopicName string `json:"topicName"`
+
+	// KeyProtoType is the fully qualified name of the proto type of record keys, e.g. "shop.v1.OrderKey"
+	KeyProtoType string `json:"keyProtoType,omitempty"`
+
+	// ValueProtoType is the fully qualified name of the proto type of record values, e.g. "shop.v1.Order"
+	ValueProtoType string `json:"valueProtoType,omitempty"`
+}
+
+// KafkaCompressionCodec is a compression codec of Kafka record batches
+// +kubebuilder:validation:Enum=none;gzip;snappy;lz4;zstd
+type KafkaCompressionCodec string
+
+const (
+	// KafkaCompressionCodecNone is no compression
+	KafkaCompressionCodecNone KafkaCompressionCodec = "none"
+	// KafkaCompressionCodecGzip is the gzip codec
+	KafkaCompressionCodecGzip KafkaCompressionCodec = "gzip"
+	// KafkaCompressionCodecSnappy is the snappy codec
+	KafkaCompressionCodecSnappy KafkaCompressionCodec = "snappy"
+	// KafkaCompressionCodecLz4 is the lz4 codec
+	KafkaCompressionCodecLz4 KafkaCompressionCodec = "lz4"
+	// KafkaCompressionCodecZstd is the zstd codec
+	KafkaCompressionCodecZstd KafkaCompressionCodec = "zstd"
+)
+
+// KafkaProxy defines the SOCKS5 proxy used to connect to Kafka
+type KafkaProxy struct {
+	// SOCKS5Address is the address of the SOCKS5 proxy, e.g. "proxy.example.com:1080"
+	SOCKS5Address string `json:"socks5Address"`
+
+	// CredentialsRef is the Secret that contains the proxy credentials
+	// The Secret should contain keys "username", "password"
+	CredentialsRef *NamespaceNameRef `json:"credentialsRef,omitempty"`
+}
+
+// KafkaSASL defines existing SASL credentials used by Console
+type KafkaSASL struct {
+	Mechanism KafkaSASLMechanism `json:"mechanism"`
+
+	// CredentialsRef is the Secret that contains SASL credentials
+	// The Secret should contain keys "username", "password", the keys can be overridden with SecretRef
+	// For OAUTHBEARER mechanism, the Secret should contain key "token"
+	// For AWS_MSK_IAM mechanism, the Secret may contain keys "accessKey", "secretKey", "sessionToken"
+	// If the keys are not set, Console uses the default AWS credentials of the pod
+	CredentialsRef NamespaceNameRef `json:"credentialsRef"`
+
+	// SecretRef overrides the keys of the username and password in CredentialsRef
+	SecretRef *KafkaSASLSecretRef `json:"secretRef,omitempty"`
+
+	// OAuth configures token refresh for OAUTHBEARER mechanism
+	OAuth *KafkaSASLOAuth `json:"oauth,omitempty"`
+
+	// AWSMSKIAM configures AWS_MSK_IAM mechanism to authenticate with Amazon MSK using IAM
+	// The operator does not provision users nor ACLs, access is granted by IAM policies
+	AWSMSKIAM *KafkaSASLAWSMSKIAM `json:"awsMskIam,omitempty"`
+
+	// Kerberos configures GSSAPI mechanism, it is required for GSSAPI and not allowed for other mechanisms
+	// The username, and the password if KeytabSecretRef is not set, are read from CredentialsRef
+	Kerberos *KafkaSASLKerberos `json:"kerberos,omitempty"`
+
+	// ManageACLsOnly creates ACLs for ExistingPrincipal in the referenced Cluster
+	// The user is managed externally, the operator still does not create a SCRAM user
+	ManageACLsOnly bool `json:"manageAclsOnly,omitempty"`
+
+	// ExistingPrincipal is the user that ACLs are created for if ManageACLsOnly is set, e.g. "console"
+	ExistingPrincipal string `json:"existingPrincipal,omitempty"`
+
+	// +kubebuilder:validation:Enum=0;1
+	// HandshakeVersion is the version of the SASL handshake request, older clusters only support version 0
+	// If not set, Console default is used
+	HandshakeVersion *int `json:"handshakeVersion,omitempty"`
+}
+
+// KafkaSASLSecretRef defines the keys of the SASL credentials in the Secret
+type KafkaSASLSecretRef struct {
+	// +kubebuilder:default=username
+	// UsernameKey is the key of the username in the Secret
+	UsernameKey string `json:"usernameKey,omitempty"`
+
+	// +kubebuilder:default=password
+	// PasswordKey is the key of the password in the Secret
+	PasswordKey string `json:"passwordKey,omitempty"`
+}
+
+// GetUsernameKey returns the key of the username in CredentialsRef, defaults to "username"
+func (s *KafkaSASL) GetUsernameKey() string {
+	if s.SecretRef != nil && s.SecretRef.UsernameKey != "" {
+		return s.SecretRef.UsernameKey
+	}
+	return corev1.BasicAuthUsernameKey
+}
+
+// GetPasswordKey returns the key of the password in CredentialsRef, defaults to "password"
+func (s *KafkaSASL) GetPasswordKey() string {
+	if s.SecretRef != nil && s.SecretRef.PasswordKey != "" {
+		return s.SecretRef.PasswordKey
+	}
+	return corev1.BasicAuthPasswordKey
+}
+
+// KafkaSASLOAuth defines configurable fields for SASL/OAUTHBEARER
+type KafkaSASLOAuth struct {
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Format=duration
+	// +kubebuilder:default="1m"
+	// RefreshBeforeExpiry is the duration before the token expires to refresh it
+	RefreshBeforeExpiry *metav1.Duration `json:"refreshBeforeExpiry,omitempty"`
+
+	// ReauthenticationEnabled re-authenticates open connections with the refreshed token
+	ReauthenticationEnabled bool `json:"reauthenticationEnabled,omitempty"`
+
+	// TLS configures the CA to verify the token endpoint, e.g. if it uses a private CA
+	TLS *KafkaSASLOAuthTLS `json:"tls,omitempty"`
+}
+
+// KafkaSASLOAuthTLS defines the TLS config of the OAUTHBEARER token endpoint
+type KafkaSASLOAuthTLS struct {
+	// CARef is the Secret in the Console namespace that contains the CA of the token endpoint
+	// The Secret should contain key "ca.crt"
+	CARef corev1.LocalObjectReference `json:"caRef"`
+}
+
+// KafkaSASLAWSMSKIAM defines configurable fields for SASL/AWS_MSK_IAM
+type KafkaSASLAWSMSKIAM struct {
+	// Region is the AWS region of the MSK cluster, e.g. "us-east-1"
+	Region string `json:"region"`
+
+	// RoleARN is the IAM role Console assumes to authenticate, e.g. "arn:aws:iam::123456789012:role/console"
+	// If not set, the credentials are used as is
+	RoleARN string `json:"roleArn,omitempty"`
+}
+
+// KafkaSASLKerberos defines configurable fields for Kerberos
+type KafkaSASLKerberos struct {
+	// Krb5ConfigMapRef is the ConfigMap that contains a custom Kerberos config, e.g. to span multiple realms
+	// The ConfigMap should contain "krb5.conf" key, it is mounted at "/etc/krb5.conf"
+	Krb5ConfigMapRef corev1.LocalObjectReference `json:"krb5ConfigMapRef"`
+
+	// Realm of the Kerberos principal, e.g. "CORP.COM"
+	Realm string `json:"realm"`
+
+	// +kubebuilder:default=kafka
+	// ServiceName is the primary of the Kafka brokers Kerberos principal
+	ServiceName string `json:"serviceName,omitempty"`
+
+	// KeytabSecretRef is the Secret that contains the keytab of the principal, Console authenticates with the keytab instead of the password
+	// The Secret should contain "krb5.keytab" key, it is mounted at "/etc/console/kerberos/krb5.keytab"
+	KeytabSecretRef *corev1.LocalObjectReference `json:"keytabSecretRef,omitempty"`
+}
+
+// KafkaSASLMechanism is the SASL mechanism used with existing credentials
+// +kubebuilder:validation:Enum=PLAIN;OAUTHBEARER;AWS_MSK_IAM;GSSAPI
+type KafkaSASLMechanism string
+
+const (
+	// KafkaSASLMechanismPlain is the SASL/PLAIN mechanism
+	KafkaSASLMechanismPlain KafkaSASLMechanism = "PLAIN"
+	// KafkaSASLMechanismOAuthBearer is the SASL/OAUTHBEARER mechanism
+	KafkaSASLMechanismOAuthBearer KafkaSASLMechanism = "OAUTHBEARER"
+	// KafkaSASLMechanismAWSMSKIAM is the SASL/AWS_MSK_IAM mechanism of Amazon MSK
+	KafkaSASLMechanismAWSMSKIAM KafkaSASLMechanism = "AWS_MSK_IAM"
+	// KafkaSASLMechanismGSSAPI is the SASL/GSSAPI mechanism of Kerberos
+	KafkaSASLMechanismGSSAPI KafkaSASLMechanism = "GSSAPI"
+)
+
+// IsExternalSASLEnabled returns true if Console uses existing SASL credentials
+func (c *Console) IsExternalSASLEnabled() bool {
+	return c.Spec.Kafka.SASL != nil
+}
+
+// IsExternalSASLManageACLsOnly returns true if the operator manages ACLs of the existing SASL user
+// ACLs are never managed for AWS_MSK_IAM mechanism, access is granted by IAM policies
+func (c *Console) IsExternalSASLManageACLsOnly() bool {
+	return c.IsExternalSASLEnabled() && c.Spec.Kafka.SASL.ManageACLsOnly && c.Spec.Kafka.SASL.Mechanism != KafkaSASLMechanismAWSMSKIAM
+}
+
+// IsKafkaSASLKerberosEnabled returns true if Console authenticates with GSSAPI mechanism
+func (c *Console) IsKafkaSASLKerberosEnabled() bool {
+	sasl := c.Spec.Kafka.SASL
+	return sasl != nil && sasl.Mechanism == KafkaSASLMechanismGSSAPI && sasl.Kerberos != nil
+}
+
+// IsKafkaSASLKerberosKeytabEnabled returns true if Console authenticates with a Kerberos keytab
+func (c *Console) IsKafkaSASLKerberosKeytabEnabled() bool {
+	return c.IsKafkaSASLKerberosEnabled() && c.Spec.Kafka.SASL.Kerberos.KeytabSecretRef != nil
+}
+
+// IsKafkaTLSEnabled returns true if Console connects to Kafka over TLS
+func (c *Console) IsKafkaTLSEnabled() bool {
+	return c.Spec.Kafka.TLS != nil
+}
+
+// IsKafkaSASLOAuthTLSEnabled returns true if the OAUTHBEARER token endpoint is verified with a custom CA
+func (c *Console) IsKafkaSASLOAuthTLSEnabled() bool {
+	sasl := c.Spec.Kafka.SASL
+	return sasl != nil && sasl.Mechanism == KafkaSASLMechanismOAuthBearer && sasl.OAuth != nil && sasl.OAuth.TLS != nil
+}
+
+// KafkaConsumer defines configurable fields for consuming records from Console
+type KafkaConsumer struct {
+	// RackAware enables fetching from the closest replica instead of the leader
+	// Requires brokers to have rack awareness configured
+	RackAware bool `json:"rackAware,omitempty"`
+
+	// +kubebuilder:validation:Minimum=1
+	// MaxConcurrentFetches is the maximum number of concurrent fetch requests when browsing messages
+	// If not set, Console default is used
+	MaxConcurrentFetches int `json:"maxConcurrentFetches,omitempty"`
+}
+
+// KafkaProducer defines configurable fields for producing records from Console
+type KafkaProducer struct {
+	// Acks is the number of acknowledgements required before a produce request is considered complete
+	Acks KafkaProducerAcks `json:"acks,omitempty"`
+}
+
+// KafkaProducerAcks is the produce acknowledgement mode
+// +kubebuilder:validation:Enum=none;leader;all
+type KafkaProducerAcks string
+
+const (
+	// KafkaProducerAcksNone does not wait for any acknowledgement
+	KafkaProducerAcksNone KafkaProducerAcks = "none"
+	// KafkaProducerAcksLeader waits for the partition leader only
+	KafkaProducerAcksLeader KafkaProducerAcks = "leader"
+	// KafkaProducerAcksAll waits for all in-sync replicas
+	KafkaProducerAcksAll KafkaProducerAcks = "all"
+)
+
 // Connect defines configurable fields for Kafka Connect
 type Connect struct {
 	// +optional
@@ -161,6 +891,10 @@ type ConnectCluster struct {
 	// TokenRef configures token header auth referenced by Secret
 	// Expects to have key "token"
 	TokenRef *corev1.ObjectReference `json:"tokenRef,omitempty"`
+
+	// HiddenConnectorClasses are connector classes hidden in Console for this cluster
+	// e.g. "org.apache.kafka.connect.mirror.MirrorSourceConnector"
+	HiddenConnectorClasses []string `json:"hiddenConnectorClasses,omitempty"`
 }
 
 // ConnectClusterTLS defines TLS certificates for the Kafka Connect cluster
@@ -176,22 +910,254 @@ type ConnectClusterTLS struct {
 
 // ConsoleStatus defines the observed state of Console
 type ConsoleStatus struct {
-	// The ConfigMap used by Console
+	// The ConfigMap used by Console, or the Secret if Deployment.ConfigInSecret is set, Kind is set accordingly
 	// This is used to pass the ConfigMap used to mount in the Deployment Resource since Ensure() only returns error
 	ConfigMapRef *corev1.ObjectReference `json:"configMapRef,omitempty"`
 
+	// ConfigHash is the hash of the config in ConfigMapRef
+	// The config ConfigMap or Secret is labeled with the hash, e.g. to list it by hash
+	ConfigHash string `json:"configHash,omitempty"`
+
 	// The generation observed by the controller
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 
 	Connectivity *Connectivity `json:"connectivity,omitempty"`
+
+	// Brokers resolved from Kafka SRVRecord
+	ResolvedBrokers []string `json:"resolvedBrokers,omitempty"`
+
+	// KafkaACLs are the inputs of the Kafka ACLs applied for Console
+	// Stale ACLs are deleted when Kafka.SASL or Kafka.ConsumerGroupPrefix change
+	KafkaACLs *ConsoleKafkaACLs `json:"kafkaACLs,omitempty"`
+
+	// ImageDigest is the digest of the image running in the Console container, e.g. "sha256:..."
+	// It is resolved from the container status imageID of a running pod
+	ImageDigest string `json:"imageDigest,omitempty"`
+
+	// ConsoleVersion is the Console version derived from the image tag, e.g. "v2.0.0"
+	ConsoleVersion string `json:"consoleVersion,omitempty"`
+
+	// UnresolvedRefs lists Secrets and ConfigMaps referenced by Console that can't be found, e.g. "Secret default/jwt"
+	// The list is cleared once all references are resolved
+	UnresolvedRefs []string `json:"unresolvedRefs,omitempty"`
+
+	// LastReconcileTime is the time of the last successful reconcile
+	// Use it to detect a stale Console that is not reconciled anymore
+	LastReconcileTime *metav1.Time `json:"lastReconcileTime,omitempty"`
+
+	// Current state of the Console
+	// +optional
+	Conditions []ConsoleCondition `json:"conditions,omitempty"`
+}
+
+// ConsoleKafkaACLs defines the Kafka ACLs applied for Console
+type ConsoleKafkaACLs struct {
+	// Principal is the user the ACLs are granted to
+	Principal string `json:"principal"`
+
+	// ConsumerGroupPrefix scopes the consumer group ACLs, all consumer groups are allowed if empty
+	ConsumerGroupPrefix string `json:"consumerGroupPrefix,omitempty"`
+}
+
+// ConsoleCondition contains details for the current conditions of the Console
+type ConsoleCondition struct {
+	// Type is the type of the condition
+	Type ConsoleConditionType `json:"type"`
+	// Status is the status of the condition
+	Status corev1.ConditionStatus `json:"status"`
+	// Last time the condition transitioned from one status to another
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+	// Unique, one-word, CamelCase reason for the condition's last transition
+	// +optional
+	Reason string `json:"reason,omitempty"`
+	// Human-readable message indicating details about last transition
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// ConsoleConditionType is a valid value for ConsoleCondition.Type
+// +kubebuilder:validation:Enum=MinReplicasUnavailable;LicenseOffline;RoleBindingsInvalid;LoginCredentialKeyMissing;LoginClientIDInvalid;LoginProviderDegraded;ConfigUnsupported
+type ConsoleConditionType string
+
+// These are valid conditions of the Console.
+const (
+	// MinReplicasUnavailableConditionType indicates that the Deployment has less available replicas than desired for longer than the grace period
+	MinReplicasUnavailableConditionType ConsoleConditionType = "MinReplicasUnavailable"
+	// LicenseOfflineConditionType indicates that the license is an offline license
+	LicenseOfflineConditionType ConsoleConditionType = "LicenseOffline"
+	// RoleBindingsInvalidConditionType indicates that the RBAC role bindings reference login providers that are not enabled
+	// or role permissions have invalid resource names
+	RoleBindingsInvalidConditionType ConsoleConditionType = "RoleBindingsInvalid"
+	// LoginCredentialKeyMissingConditionType indicates that the Secret referenced by a login provider lacks required keys
+	LoginCredentialKeyMissingConditionType ConsoleConditionType = "LoginCredentialKeyMissing"
+	// LoginClientIDInvalidConditionType indicates that the client ID of a login provider is malformed
+	LoginClientIDInvalidConditionType ConsoleConditionType = "LoginClientIDInvalid"
+	// LoginProviderDegradedConditionType indicates that a login provider references resources that can't be found
+	LoginProviderDegradedConditionType ConsoleConditionType = "LoginProviderDegraded"
+	// ConfigUnsupportedConditionType indicates that the generated config is rejected by the Console version the operator supports
+	// The config is not written, the Console keeps running with the previous config
+	ConfigUnsupportedConditionType ConsoleConditionType = "ConfigUnsupported"
+)
+
+// These are valid reasons for MinReplicasUnavailable
+const (
+	// MinReplicasUnavailableReasonAvailable indicates that all desired replicas are available
+	MinReplicasUnavailableReasonAvailable = "MinimumReplicasAvailable"
+	// MinReplicasUnavailableReasonPending indicates that replicas are unavailable but still within the grace period
+	MinReplicasUnavailableReasonPending = "WithinGracePeriod"
+	// MinReplicasUnavailableReasonUnavailable indicates that replicas are unavailable for longer than the grace period
+	MinReplicasUnavailableReasonUnavailable = "MinimumReplicasUnavailable"
+)
+
+// These are valid reasons for LicenseOffline
+const (
+	// LicenseOfflineReasonOffline indicates that the license is used without contacting the license server
+	LicenseOfflineReasonOffline = "OfflineLicense"
+	// LicenseOfflineReasonOnline indicates that the license is not an offline license
+	LicenseOfflineReasonOnline = "OnlineLicense"
+)
+
+// These are valid reasons for RoleBindingsInvalid
+const (
+	// RoleBindingsInvalidReasonValid indicates that all role binding subjects reference enabled login providers
+	RoleBindingsInvalidReasonValid = "RoleBindingsValid"
+	// RoleBindingsInvalidReasonProviderDisabled indicates that a role binding subject references a login provider that is not enabled
+	RoleBindingsInvalidReasonProviderDisabled = "ProviderDisabled"
+	// RoleBindingsInvalidReasonInvalidPermission indicates that a role permission has an invalid resource name pattern
+	RoleBindingsInvalidReasonInvalidPermission = "InvalidPermission"
+	// RoleBindingsInvalidReasonInheritanceCycle indicates that roles inherit each other in a cycle
+	RoleBindingsInvalidReasonInheritanceCycle = "InheritanceCycle"
+)
+
+// These are valid reasons for LoginCredentialKeyMissing
+const (
+	// LoginCredentialKeyMissingReasonPresent indicates that the login credentials Secret contains all required keys
+	LoginCredentialKeyMissingReasonPresent = "CredentialKeysPresent"
+	// LoginCredentialKeyMissingReasonMissing indicates that the login credentials Secret lacks a required key
+	LoginCredentialKeyMissingReasonMissing = "CredentialKeyMissing"
+)
+
+// These are valid reasons for LoginClientIDInvalid
+const (
+	// LoginClientIDInvalidReasonValid indicates that the client ID has the format expected by the login provider
+	LoginClientIDInvalidReasonValid = "ClientIDValid"
+	// LoginClientIDInvalidReasonMalformed indicates that the client ID does not have the format expected by the login provider
+	LoginClientIDInvalidReasonMalformed = "ClientIDMalformed"
+)
+
+// These are valid reasons for LoginProviderDegraded
+const (
+	// LoginProviderDegradedReasonAvailable indicates that all resources referenced by the login provider are found
+	LoginProviderDegradedReasonAvailable = "LoginProviderAvailable"
+	// LoginProviderDegradedReasonOrganizationsNotFound indicates that the ConfigMap listing the allowed GitHub organizations is not found
+	LoginProviderDegradedReasonOrganizationsNotFound = "OrganizationsNotFound"
+)
+
+// These are valid reasons for ConfigUnsupported
+const (
+	// ConfigUnsupportedReasonSupported indicates that the generated config is accepted by Console
+	ConfigUnsupportedReasonSupported = "ConfigSupported"
+	// ConfigUnsupportedReasonUnknownKeys indicates that the generated config has keys unknown to Console
+	ConfigUnsupportedReasonUnknownKeys = "UnknownConfigKeys"
+)
+
+// GetCondition return the condition of the given type
+func (s *ConsoleStatus) GetCondition(
+	cType ConsoleConditionType,
+) *ConsoleCondition {
+	for i := range s.Conditions {
+		if s.Conditions[i].Type == cType {
+			return &s.Conditions[i]
+		}
+	}
+	return nil
+}
+
+// GetConditionStatus is a shortcut to directly get the status of a given condition
+func (s *ConsoleStatus) GetConditionStatus(
+	cType ConsoleConditionType,
+) corev1.ConditionStatus {
+	cond := s.GetCondition(cType)
+	if cond == nil {
+		return corev1.ConditionUnknown
+	}
+	return cond.Status
+}
+
+// SetCondition allows setting a condition of a given type.
+// In case of change in any value other than the lastTransitionTime, the lastTransitionTime
+// field will be set to the current timestamp. The return value indicates if a change has happened.
+func (s *ConsoleStatus) SetCondition(
+	cType ConsoleConditionType,
+	status corev1.ConditionStatus,
+	reason, message string,
+) bool {
+	return s.SetConditionUsingClock(cType, status, reason, message, time.Now)
+}
+
+// SetConditionUsingClock is similar to SetCondition, but allows to specify the function to get the system clock from.
+func (s *ConsoleStatus) SetConditionUsingClock(
+	cType ConsoleConditionType,
+	status corev1.ConditionStatus,
+	reason, message string,
+	clock func() time.Time,
+) bool {
+	update := func(c *ConsoleCondition) bool {
+		changed := c.Status != status || c.Reason != reason || c.Message != message
+		if changed {
+			c.LastTransitionTime = metav1.NewTime(clock())
+		}
+		c.Type = cType
+		c.Status = status
+		c.Reason = reason
+		c.Message = message
+		return changed
+	}
+	// Try updating existing condition
+	for i := range s.Conditions {
+		if s.Conditions[i].Type == cType {
+			return update(&s.Conditions[i])
+		}
+	}
+	// Add a new one if missing
+	newCond := ConsoleCondition{}
+	update(&newCond)
+	s.Conditions = append(s.Conditions, newCond)
+	return true
 }
 
 // Connectivity defines internal/external hosts
 type Connectivity struct {
+	// Internal is the address of Console within the cluster
+	// For a headless Service the DNS name resolves to the ready pods
 	Internal string `json:"internal,omitempty"`
 	External string `json:"external,omitempty"`
 }
 
+// LicenseSecretKeyRef is the Secret key that contains the Console license
+type LicenseSecretKeyRef struct {
+	SecretKeyRef `json:",inline"`
+
+	// +kubebuilder:default=auto
+	// Encoding of the license value in the Secret
+	// With "auto", the value is decoded if it is base64 encoded text, otherwise it is used as is
+	Encoding LicenseEncoding `json:"encoding,omitempty"`
+}
+
+// LicenseEncoding is the encoding of the license value in the Secret
+// +kubebuilder:validation:Enum=auto;raw;base64
+type LicenseEncoding string
+
+const (
+	// LicenseEncodingAuto detects if the license is base64 encoded
+	LicenseEncodingAuto LicenseEncoding = "auto"
+	// LicenseEncodingRaw uses the license as is
+	LicenseEncodingRaw LicenseEncoding = "raw"
+	// LicenseEncodingBase64 decodes the base64 encoded license
+	LicenseEncodingBase64 LicenseEncoding = "base64"
+)
+
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
 
@@ -54,6 +54,21 @@ func (in *AdminAPITLS) DeepCopy() *AdminAPITLS {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Autoscaling) DeepCopyInto(out *Autoscaling) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Autoscaling.
+func (in *Autoscaling) DeepCopy() *Autoscaling {
+	if in == nil {
+		return nil
+	}
+	out := new(Autoscaling)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CloudStorageConfig) DeepCopyInto(out *CloudStorageConfig) {
 	*out = *in
@@ -301,6 +316,11 @@ func (in *ConnectCluster) DeepCopyInto(out *ConnectCluster) {
 		*out = new(v1.ObjectReference)
 		**out = **in
 	}
+	if in.HiddenConnectorClasses != nil {
+		in, out := &in.HiddenConnectorClasses, &out.HiddenConnectorClasses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectCluster.
@@ -375,6 +395,52 @@ func (in *Console) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConsoleBranding) DeepCopyInto(out *ConsoleBranding) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConsoleBranding.
+func (in *ConsoleBranding) DeepCopy() *ConsoleBranding {
+	if in == nil {
+		return nil
+	}
+	out := new(ConsoleBranding)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConsoleCondition) DeepCopyInto(out *ConsoleCondition) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConsoleCondition.
+func (in *ConsoleCondition) DeepCopy() *ConsoleCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(ConsoleCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConsoleKafkaACLs) DeepCopyInto(out *ConsoleKafkaACLs) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConsoleKafkaACLs.
+func (in *ConsoleKafkaACLs) DeepCopy() *ConsoleKafkaACLs {
+	if in == nil {
+		return nil
+	}
+	out := new(ConsoleKafkaACLs)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConsoleList) DeepCopyInto(out *ConsoleList) {
 	*out = *in
@@ -407,22 +473,49 @@ func (in *ConsoleList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConsoleSettings) DeepCopyInto(out *ConsoleSettings) {
+	*out = *in
+	if in.StatsRefreshInterval != nil {
+		in, out := &in.StatsRefreshInterval, &out.StatsRefreshInterval
+		*out = new(apismetav1.Duration)
+		**out = **in
+	}
+	if in.Branding != nil {
+		in, out := &in.Branding, &out.Branding
+		*out = new(ConsoleBranding)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConsoleSettings.
+func (in *ConsoleSettings) DeepCopy() *ConsoleSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(ConsoleSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConsoleSpec) DeepCopyInto(out *ConsoleSpec) {
 	*out = *in
 	in.Server.DeepCopyInto(&out.Server)
-	out.SchemaRegistry = in.SchemaRegistry
+	in.SchemaRegistry.DeepCopyInto(&out.SchemaRegistry)
 	out.ClusterRef = in.ClusterRef
-	out.Deployment = in.Deployment
+	in.Deployment.DeepCopyInto(&out.Deployment)
 	in.Connect.DeepCopyInto(&out.Connect)
+	in.Kafka.DeepCopyInto(&out.Kafka)
+	in.Console.DeepCopyInto(&out.Console)
 	if in.Enterprise != nil {
 		in, out := &in.Enterprise, &out.Enterprise
 		*out = new(Enterprise)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.LicenseRef != nil {
 		in, out := &in.LicenseRef, &out.LicenseRef
-		*out = new(SecretKeyRef)
+		*out = new(LicenseSecretKeyRef)
 		**out = **in
 	}
 	if in.Login != nil {
@@ -430,6 +523,16 @@ func (in *ConsoleSpec) DeepCopyInto(out *ConsoleSpec) {
 		*out = new(EnterpriseLogin)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.HelmRelease != nil {
+		in, out := &in.HelmRelease, &out.HelmRelease
+		*out = new(HelmRelease)
+		**out = **in
+	}
+	if in.ConfigTemplateRef != nil {
+		in, out := &in.ConfigTemplateRef, &out.ConfigTemplateRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConsoleSpec.
@@ -455,6 +558,32 @@ func (in *ConsoleStatus) DeepCopyInto(out *ConsoleStatus) {
 		*out = new(Connectivity)
 		**out = **in
 	}
+	if in.ResolvedBrokers != nil {
+		in, out := &in.ResolvedBrokers, &out.ResolvedBrokers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.KafkaACLs != nil {
+		in, out := &in.KafkaACLs, &out.KafkaACLs
+		*out = new(ConsoleKafkaACLs)
+		**out = **in
+	}
+	if in.UnresolvedRefs != nil {
+		in, out := &in.UnresolvedRefs, &out.UnresolvedRefs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastReconcileTime != nil {
+		in, out := &in.LastReconcileTime, &out.LastReconcileTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]ConsoleCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConsoleStatus.
@@ -470,6 +599,55 @@ func (in *ConsoleStatus) DeepCopy() *ConsoleStatus {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Deployment) DeepCopyInto(out *Deployment) {
 	*out = *in
+	if in.ReplicasUnavailableGracePeriod != nil {
+		in, out := &in.ReplicasUnavailableGracePeriod, &out.ReplicasUnavailableGracePeriod
+		*out = new(apismetav1.Duration)
+		**out = **in
+	}
+	if in.ServiceMesh != nil {
+		in, out := &in.ServiceMesh, &out.ServiceMesh
+		*out = new(ServiceMesh)
+		**out = **in
+	}
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(Autoscaling)
+		**out = **in
+	}
+	if in.SecurityContext != nil {
+		in, out := &in.SecurityContext, &out.SecurityContext
+		*out = new(v1.SecurityContext)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ImagePullSecrets != nil {
+		in, out := &in.ImagePullSecrets, &out.ImagePullSecrets
+		*out = make([]v1.LocalObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.DrainTimeout != nil {
+		in, out := &in.DrainTimeout, &out.DrainTimeout
+		*out = new(apismetav1.Duration)
+		**out = **in
+	}
+	if in.InitContainers != nil {
+		in, out := &in.InitContainers, &out.InitContainers
+		*out = make([]v1.Container, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = new(DeploymentMetrics)
+		**out = **in
+	}
+	if in.ReadinessGates != nil {
+		in, out := &in.ReadinessGates, &out.ReadinessGates
+		*out = make([]v1.PodReadinessGate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Deployment.
@@ -482,10 +660,45 @@ func (in *Deployment) DeepCopy() *Deployment {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentMetrics) DeepCopyInto(out *DeploymentMetrics) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentMetrics.
+func (in *DeploymentMetrics) DeepCopy() *DeploymentMetrics {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentMetrics)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainRoleBinding) DeepCopyInto(out *DomainRoleBinding) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainRoleBinding.
+func (in *DomainRoleBinding) DeepCopy() *DomainRoleBinding {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainRoleBinding)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Enterprise) DeepCopyInto(out *Enterprise) {
 	*out = *in
-	out.RBAC = in.RBAC
+	in.RBAC.DeepCopyInto(&out.RBAC)
+	if in.AuditLog != nil {
+		in, out := &in.AuditLog, &out.AuditLog
+		*out = new(EnterpriseAuditLog)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Enterprise.
@@ -498,10 +711,35 @@ func (in *Enterprise) DeepCopy() *Enterprise {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnterpriseAuditLog) DeepCopyInto(out *EnterpriseAuditLog) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnterpriseAuditLog.
+func (in *EnterpriseAuditLog) DeepCopy() *EnterpriseAuditLog {
+	if in == nil {
+		return nil
+	}
+	out := new(EnterpriseAuditLog)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EnterpriseLogin) DeepCopyInto(out *EnterpriseLogin) {
 	*out = *in
 	out.JWTSecretRef = in.JWTSecretRef
+	if in.JWTRotation != nil {
+		in, out := &in.JWTRotation, &out.JWTRotation
+		*out = new(EnterpriseLoginJWTRotation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Session != nil {
+		in, out := &in.Session, &out.Session
+		*out = new(EnterpriseLoginSession)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Google != nil {
 		in, out := &in.Google, &out.Google
 		*out = new(EnterpriseLoginGoogle)
@@ -510,7 +748,17 @@ func (in *EnterpriseLogin) DeepCopyInto(out *EnterpriseLogin) {
 	if in.RedpandaCloud != nil {
 		in, out := &in.RedpandaCloud, &out.RedpandaCloud
 		*out = new(EnterpriseLoginRedpandaCloud)
-		**out = **in
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OIDC != nil {
+		in, out := &in.OIDC, &out.OIDC
+		*out = new(EnterpriseLoginOIDC)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GitHub != nil {
+		in, out := &in.GitHub, &out.GitHub
+		*out = new(EnterpriseLoginGitHub)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
@@ -524,6 +772,32 @@ func (in *EnterpriseLogin) DeepCopy() *EnterpriseLogin {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnterpriseLoginGitHub) DeepCopyInto(out *EnterpriseLoginGitHub) {
+	*out = *in
+	out.ClientCredentialsRef = in.ClientCredentialsRef
+	if in.OrganizationsRef != nil {
+		in, out := &in.OrganizationsRef, &out.OrganizationsRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
+	if in.Teams != nil {
+		in, out := &in.Teams, &out.Teams
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnterpriseLoginGitHub.
+func (in *EnterpriseLoginGitHub) DeepCopy() *EnterpriseLoginGitHub {
+	if in == nil {
+		return nil
+	}
+	out := new(EnterpriseLoginGitHub)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EnterpriseLoginGoogle) DeepCopyInto(out *EnterpriseLoginGoogle) {
 	*out = *in
@@ -531,7 +805,19 @@ func (in *EnterpriseLoginGoogle) DeepCopyInto(out *EnterpriseLoginGoogle) {
 	if in.Directory != nil {
 		in, out := &in.Directory, &out.Directory
 		*out = new(EnterpriseLoginGoogleDirectory)
-		**out = **in
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdditionalScopes != nil {
+		in, out := &in.AdditionalScopes, &out.AdditionalScopes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ClaimMappings != nil {
+		in, out := &in.ClaimMappings, &out.ClaimMappings
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
 	}
 }
 
@@ -549,6 +835,11 @@ func (in *EnterpriseLoginGoogle) DeepCopy() *EnterpriseLoginGoogle {
 func (in *EnterpriseLoginGoogleDirectory) DeepCopyInto(out *EnterpriseLoginGoogleDirectory) {
 	*out = *in
 	out.ServiceAccountRef = in.ServiceAccountRef
+	if in.RefreshInterval != nil {
+		in, out := &in.RefreshInterval, &out.RefreshInterval
+		*out = new(apismetav1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnterpriseLoginGoogleDirectory.
@@ -561,9 +852,62 @@ func (in *EnterpriseLoginGoogleDirectory) DeepCopy() *EnterpriseLoginGoogleDirec
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnterpriseLoginJWTRotation) DeepCopyInto(out *EnterpriseLoginJWTRotation) {
+	*out = *in
+	if in.AllowPreviousSecretRef != nil {
+		in, out := &in.AllowPreviousSecretRef, &out.AllowPreviousSecretRef
+		*out = new(SecretKeyRef)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnterpriseLoginJWTRotation.
+func (in *EnterpriseLoginJWTRotation) DeepCopy() *EnterpriseLoginJWTRotation {
+	if in == nil {
+		return nil
+	}
+	out := new(EnterpriseLoginJWTRotation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnterpriseLoginOIDC) DeepCopyInto(out *EnterpriseLoginOIDC) {
+	*out = *in
+	out.ClientCredentialsRef = in.ClientCredentialsRef
+	if in.Scopes != nil {
+		in, out := &in.Scopes, &out.Scopes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnterpriseLoginOIDC.
+func (in *EnterpriseLoginOIDC) DeepCopy() *EnterpriseLoginOIDC {
+	if in == nil {
+		return nil
+	}
+	out := new(EnterpriseLoginOIDC)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EnterpriseLoginRedpandaCloud) DeepCopyInto(out *EnterpriseLoginRedpandaCloud) {
 	*out = *in
+	if in.AdditionalScopes != nil {
+		in, out := &in.AdditionalScopes, &out.AdditionalScopes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ClaimMappings != nil {
+		in, out := &in.ClaimMappings, &out.ClaimMappings
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnterpriseLoginRedpandaCloud.
@@ -576,10 +920,45 @@ func (in *EnterpriseLoginRedpandaCloud) DeepCopy() *EnterpriseLoginRedpandaCloud
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnterpriseLoginSession) DeepCopyInto(out *EnterpriseLoginSession) {
+	*out = *in
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(apismetav1.Duration)
+		**out = **in
+	}
+	if in.IdleTimeout != nil {
+		in, out := &in.IdleTimeout, &out.IdleTimeout
+		*out = new(apismetav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnterpriseLoginSession.
+func (in *EnterpriseLoginSession) DeepCopy() *EnterpriseLoginSession {
+	if in == nil {
+		return nil
+	}
+	out := new(EnterpriseLoginSession)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EnterpriseRBAC) DeepCopyInto(out *EnterpriseRBAC) {
 	*out = *in
 	out.RoleBindingsRef = in.RoleBindingsRef
+	if in.EmailDomainBindings != nil {
+		in, out := &in.EmailDomainBindings, &out.EmailDomainBindings
+		*out = make([]DomainRoleBinding, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedRoles != nil {
+		in, out := &in.AllowedRoles, &out.AllowedRoles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnterpriseRBAC.
@@ -613,31 +992,138 @@ func (in *ExternalConnectivityConfig) DeepCopy() *ExternalConnectivityConfig {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaAPI) DeepCopyInto(out *KafkaAPI) {
+func (in *HelmRelease) DeepCopyInto(out *HelmRelease) {
 	*out = *in
-	in.External.DeepCopyInto(&out.External)
-	in.TLS.DeepCopyInto(&out.TLS)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaAPI.
-func (in *KafkaAPI) DeepCopy() *KafkaAPI {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmRelease.
+func (in *HelmRelease) DeepCopy() *HelmRelease {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaAPI)
+	out := new(HelmRelease)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaAPITLS) DeepCopyInto(out *KafkaAPITLS) {
+func (in *Kafka) DeepCopyInto(out *Kafka) {
 	*out = *in
-	if in.IssuerRef != nil {
-		in, out := &in.IssuerRef, &out.IssuerRef
-		*out = new(metav1.ObjectReference)
+	if in.SRVRefreshInterval != nil {
+		in, out := &in.SRVRefreshInterval, &out.SRVRefreshInterval
+		*out = new(apismetav1.Duration)
 		**out = **in
 	}
-	if in.NodeSecretRef != nil {
+	if in.Producer != nil {
+		in, out := &in.Producer, &out.Producer
+		*out = new(KafkaProducer)
+		**out = **in
+	}
+	if in.Consumer != nil {
+		in, out := &in.Consumer, &out.Consumer
+		*out = new(KafkaConsumer)
+		**out = **in
+	}
+	if in.SASL != nil {
+		in, out := &in.SASL, &out.SASL
+		*out = new(KafkaSASL)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RequestTimeoutOverrides != nil {
+		in, out := &in.RequestTimeoutOverrides, &out.RequestTimeoutOverrides
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Proxy != nil {
+		in, out := &in.Proxy, &out.Proxy
+		*out = new(KafkaProxy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SupportedCompressionCodecs != nil {
+		in, out := &in.SupportedCompressionCodecs, &out.SupportedCompressionCodecs
+		*out = make([]KafkaCompressionCodec, len(*in))
+		copy(*out, *in)
+	}
+	if in.ConnectionMaxIdle != nil {
+		in, out := &in.ConnectionMaxIdle, &out.ConnectionMaxIdle
+		*out = new(apismetav1.Duration)
+		**out = **in
+	}
+	if in.BrokerTimeout != nil {
+		in, out := &in.BrokerTimeout, &out.BrokerTimeout
+		*out = new(apismetav1.Duration)
+		**out = **in
+	}
+	if in.Retries != nil {
+		in, out := &in.Retries, &out.Retries
+		*out = new(int)
+		**out = **in
+	}
+	if in.RetryBackoff != nil {
+		in, out := &in.RetryBackoff, &out.RetryBackoff
+		*out = new(apismetav1.Duration)
+		**out = **in
+	}
+	if in.SessionTimeout != nil {
+		in, out := &in.SessionTimeout, &out.SessionTimeout
+		*out = new(apismetav1.Duration)
+		**out = **in
+	}
+	if in.HeartbeatInterval != nil {
+		in, out := &in.HeartbeatInterval, &out.HeartbeatInterval
+		*out = new(apismetav1.Duration)
+		**out = **in
+	}
+	if in.Protobuf != nil {
+		in, out := &in.Protobuf, &out.Protobuf
+		*out = new(KafkaProtobuf)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(KafkaTLS)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Kafka.
+func (in *Kafka) DeepCopy() *Kafka {
+	if in == nil {
+		return nil
+	}
+	out := new(Kafka)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaAPI) DeepCopyInto(out *KafkaAPI) {
+	*out = *in
+	in.External.DeepCopyInto(&out.External)
+	in.TLS.DeepCopyInto(&out.TLS)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaAPI.
+func (in *KafkaAPI) DeepCopy() *KafkaAPI {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaAPI)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaAPITLS) DeepCopyInto(out *KafkaAPITLS) {
+	*out = *in
+	if in.IssuerRef != nil {
+		in, out := &in.IssuerRef, &out.IssuerRef
+		*out = new(metav1.ObjectReference)
+		**out = **in
+	}
+	if in.NodeSecretRef != nil {
 		in, out := &in.NodeSecretRef, &out.NodeSecretRef
 		*out = new(v1.ObjectReference)
 		**out = **in
@@ -654,6 +1140,261 @@ func (in *KafkaAPITLS) DeepCopy() *KafkaAPITLS {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaConsumer) DeepCopyInto(out *KafkaConsumer) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConsumer.
+func (in *KafkaConsumer) DeepCopy() *KafkaConsumer {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaConsumer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaProducer) DeepCopyInto(out *KafkaProducer) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaProducer.
+func (in *KafkaProducer) DeepCopy() *KafkaProducer {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaProducer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaProtobuf) DeepCopyInto(out *KafkaProtobuf) {
+	*out = *in
+	out.DescriptorConfigMapRef = in.DescriptorConfigMapRef
+	if in.Mappings != nil {
+		in, out := &in.Mappings, &out.Mappings
+		*out = make([]KafkaProtobufTopicMapping, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaProtobuf.
+func (in *KafkaProtobuf) DeepCopy() *KafkaProtobuf {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaProtobuf)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaProtobufTopicMapping) DeepCopyInto(out *KafkaProtobufTopicMapping) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaProtobufTopicMapping.
+func (in *KafkaProtobufTopicMapping) DeepCopy() *KafkaProtobufTopicMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaProtobufTopicMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaProxy) DeepCopyInto(out *KafkaProxy) {
+	*out = *in
+	if in.CredentialsRef != nil {
+		in, out := &in.CredentialsRef, &out.CredentialsRef
+		*out = new(NamespaceNameRef)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaProxy.
+func (in *KafkaProxy) DeepCopy() *KafkaProxy {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaProxy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaSASL) DeepCopyInto(out *KafkaSASL) {
+	*out = *in
+	out.CredentialsRef = in.CredentialsRef
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(KafkaSASLSecretRef)
+		**out = **in
+	}
+	if in.OAuth != nil {
+		in, out := &in.OAuth, &out.OAuth
+		*out = new(KafkaSASLOAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AWSMSKIAM != nil {
+		in, out := &in.AWSMSKIAM, &out.AWSMSKIAM
+		*out = new(KafkaSASLAWSMSKIAM)
+		**out = **in
+	}
+	if in.Kerberos != nil {
+		in, out := &in.Kerberos, &out.Kerberos
+		*out = new(KafkaSASLKerberos)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HandshakeVersion != nil {
+		in, out := &in.HandshakeVersion, &out.HandshakeVersion
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSASL.
+func (in *KafkaSASL) DeepCopy() *KafkaSASL {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaSASL)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaSASLAWSMSKIAM) DeepCopyInto(out *KafkaSASLAWSMSKIAM) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSASLAWSMSKIAM.
+func (in *KafkaSASLAWSMSKIAM) DeepCopy() *KafkaSASLAWSMSKIAM {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaSASLAWSMSKIAM)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaSASLKerberos) DeepCopyInto(out *KafkaSASLKerberos) {
+	*out = *in
+	out.Krb5ConfigMapRef = in.Krb5ConfigMapRef
+	if in.KeytabSecretRef != nil {
+		in, out := &in.KeytabSecretRef, &out.KeytabSecretRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSASLKerberos.
+func (in *KafkaSASLKerberos) DeepCopy() *KafkaSASLKerberos {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaSASLKerberos)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaSASLOAuth) DeepCopyInto(out *KafkaSASLOAuth) {
+	*out = *in
+	if in.RefreshBeforeExpiry != nil {
+		in, out := &in.RefreshBeforeExpiry, &out.RefreshBeforeExpiry
+		*out = new(apismetav1.Duration)
+		**out = **in
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(KafkaSASLOAuthTLS)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSASLOAuth.
+func (in *KafkaSASLOAuth) DeepCopy() *KafkaSASLOAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaSASLOAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaSASLOAuthTLS) DeepCopyInto(out *KafkaSASLOAuthTLS) {
+	*out = *in
+	out.CARef = in.CARef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSASLOAuthTLS.
+func (in *KafkaSASLOAuthTLS) DeepCopy() *KafkaSASLOAuthTLS {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaSASLOAuthTLS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaSASLSecretRef) DeepCopyInto(out *KafkaSASLSecretRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSASLSecretRef.
+func (in *KafkaSASLSecretRef) DeepCopy() *KafkaSASLSecretRef {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaSASLSecretRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaTLS) DeepCopyInto(out *KafkaTLS) {
+	*out = *in
+	if in.CARef != nil {
+		in, out := &in.CARef, &out.CARef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaTLS.
+func (in *KafkaTLS) DeepCopy() *KafkaTLS {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaTLS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LicenseSecretKeyRef) DeepCopyInto(out *LicenseSecretKeyRef) {
+	*out = *in
+	out.SecretKeyRef = in.SecretKeyRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LicenseSecretKeyRef.
+func (in *LicenseSecretKeyRef) DeepCopy() *LicenseSecretKeyRef {
+	if in == nil {
+		return nil
+	}
+	out := new(LicenseSecretKeyRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ListenerWithName) DeepCopyInto(out *ListenerWithName) {
 	*out = *in
@@ -918,6 +1659,21 @@ func (in *RestartConfig) DeepCopy() *RestartConfig {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Schema) DeepCopyInto(out *Schema) {
 	*out = *in
+	if in.RequestTimeout != nil {
+		in, out := &in.RequestTimeout, &out.RequestTimeout
+		*out = new(apismetav1.Duration)
+		**out = **in
+	}
+	if in.BasicAuthRef != nil {
+		in, out := &in.BasicAuthRef, &out.BasicAuthRef
+		*out = new(SchemaBasicAuthRef)
+		**out = **in
+	}
+	if in.PasswordRef != nil {
+		in, out := &in.PasswordRef, &out.PasswordRef
+		*out = new(SecretKeyRef)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Schema.
@@ -930,6 +1686,21 @@ func (in *Schema) DeepCopy() *Schema {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchemaBasicAuthRef) DeepCopyInto(out *SchemaBasicAuthRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchemaBasicAuthRef.
+func (in *SchemaBasicAuthRef) DeepCopy() *SchemaBasicAuthRef {
+	if in == nil {
+		return nil
+	}
+	out := new(SchemaBasicAuthRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SchemaRegistryAPI) DeepCopyInto(out *SchemaRegistryAPI) {
 	*out = *in
@@ -1038,6 +1809,27 @@ func (in *Server) DeepCopyInto(out *Server) {
 		*out = new(apismetav1.Duration)
 		**out = **in
 	}
+	in.UI.DeepCopyInto(&out.UI)
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(ServerTLS)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RequestID != nil {
+		in, out := &in.RequestID, &out.RequestID
+		*out = new(ServerRequestID)
+		**out = **in
+	}
+	if in.MetricsAuth != nil {
+		in, out := &in.MetricsAuth, &out.MetricsAuth
+		*out = new(ServerMetricsAuth)
+		**out = **in
+	}
+	if in.AccessLog != nil {
+		in, out := &in.AccessLog, &out.AccessLog
+		*out = new(ServerAccessLog)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Server.
@@ -1050,6 +1842,129 @@ func (in *Server) DeepCopy() *Server {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServerAccessLog) DeepCopyInto(out *ServerAccessLog) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerAccessLog.
+func (in *ServerAccessLog) DeepCopy() *ServerAccessLog {
+	if in == nil {
+		return nil
+	}
+	out := new(ServerAccessLog)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServerMetricsAuth) DeepCopyInto(out *ServerMetricsAuth) {
+	*out = *in
+	out.BasicAuthRef = in.BasicAuthRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerMetricsAuth.
+func (in *ServerMetricsAuth) DeepCopy() *ServerMetricsAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(ServerMetricsAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServerRequestID) DeepCopyInto(out *ServerRequestID) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerRequestID.
+func (in *ServerRequestID) DeepCopy() *ServerRequestID {
+	if in == nil {
+		return nil
+	}
+	out := new(ServerRequestID)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServerTLS) DeepCopyInto(out *ServerTLS) {
+	*out = *in
+	out.SecretRef = in.SecretRef
+	if in.ClientAuth != nil {
+		in, out := &in.ClientAuth, &out.ClientAuth
+		*out = new(ServerTLSClientAuth)
+		**out = **in
+	}
+	if in.ReloadInterval != nil {
+		in, out := &in.ReloadInterval, &out.ReloadInterval
+		*out = new(apismetav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerTLS.
+func (in *ServerTLS) DeepCopy() *ServerTLS {
+	if in == nil {
+		return nil
+	}
+	out := new(ServerTLS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServerTLSClientAuth) DeepCopyInto(out *ServerTLSClientAuth) {
+	*out = *in
+	out.CARef = in.CARef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerTLSClientAuth.
+func (in *ServerTLSClientAuth) DeepCopy() *ServerTLSClientAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(ServerTLSClientAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServerUI) DeepCopyInto(out *ServerUI) {
+	*out = *in
+	if in.RefreshInterval != nil {
+		in, out := &in.RefreshInterval, &out.RefreshInterval
+		*out = new(apismetav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerUI.
+func (in *ServerUI) DeepCopy() *ServerUI {
+	if in == nil {
+		return nil
+	}
+	out := new(ServerUI)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceMesh) DeepCopyInto(out *ServiceMesh) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceMesh.
+func (in *ServiceMesh) DeepCopy() *ServiceMesh {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceMesh)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Sidecar) DeepCopyInto(out *Sidecar) {
 	*out = *in
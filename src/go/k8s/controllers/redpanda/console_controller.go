@@ -13,6 +13,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"reflect"
 
 	"github.com/go-logr/logr"
 	redpandav1alpha1 "github.com/redpanda-data/redpanda/src/go/k8s/apis/redpanda/v1alpha1"
@@ -20,12 +21,18 @@ import (
 	consolepkg "github.com/redpanda-data/redpanda/src/go/k8s/pkg/console"
 	"github.com/redpanda-data/redpanda/src/go/k8s/pkg/resources"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 )
 
 // ConsoleReconciler reconciles a Console object
@@ -38,6 +45,9 @@ type ConsoleReconciler struct {
 	Store                   *consolepkg.Store
 	EventRecorder           record.EventRecorder
 	KafkaAdminClientFactory consolepkg.KafkaAdminClientFactory
+	SRVResolver             consolepkg.SRVResolver
+	maxConcurrentReconciles int
+	finalizerPrefix         string
 }
 
 const (
@@ -51,6 +61,9 @@ const (
 //+kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=apps,resources=configmaps,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups="",resources=events,verbs=get;list;watch;create;update;patch
+//+kubebuilder:rbac:groups=core,resources=serviceaccounts,verbs=delete
+//+kubebuilder:rbac:groups=core,resources=pods,verbs=get;list;watch
+//+kubebuilder:rbac:groups=autoscaling,resources=horizontalpodautoscalers,verbs=get;list;watch;create;update;patch;delete
 
 //+kubebuilder:rbac:groups=redpanda.vectorized.io,resources=consoles,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=redpanda.vectorized.io,resources=consoles/status,verbs=get;update;patch
@@ -99,6 +112,9 @@ func (r *ConsoleReconciler) Reconcile(
 		return ctrl.Result{Requeue: true}, nil
 	}
 
+	// The spec may change while reconciling, only the generation the reconcile started with is observed
+	generation := console.GetGeneration()
+
 	var s state
 	switch {
 	case console.GetDeletionTimestamp() != nil:
@@ -109,14 +125,18 @@ func (r *ConsoleReconciler) Reconcile(
 		}
 		fallthrough
 	default:
-		s = &Reconciling{r}
+		s = &Reconciling{ConsoleReconciler: r, generation: generation}
 	}
 
 	return s.Do(ctx, console, cluster, log)
 }
 
 // Reconciling is the state of the Console that handles reconciliation
-type Reconciling ConsoleState
+type Reconciling struct {
+	*ConsoleReconciler
+	// generation is the Console generation when the reconcile started
+	generation int64
+}
 
 // Do handles reconciliation of Console
 func (r *Reconciling) Do(
@@ -130,6 +150,10 @@ func (r *Reconciling) Do(
 		return ctrl.Result{}, fmt.Errorf("sync console store: %w", err)
 	}
 
+	if err := r.resolveBrokers(ctx, console); err != nil {
+		return ctrl.Result{}, fmt.Errorf("resolving brokers: %w", err)
+	}
+
 	// ConfigMap is set to immutable and a new one is created if needed every reconcile
 	// Cleanup unused ConfigMaps before ensuring Resources which might create new ConfigMaps again
 	// Otherwise, if reconciliation always fail, a lot of unused ConfigMaps will be created
@@ -151,12 +175,18 @@ func (r *Reconciling) Do(
 	}
 
 	applyResources := []resources.Resource{
-		consolepkg.NewKafkaSA(r.Client, r.Scheme, console, cluster, r.clusterDomain, r.AdminAPIClientFactory, log),
-		consolepkg.NewKafkaACL(r.Client, r.Scheme, console, cluster, r.KafkaAdminClientFactory, log),
+		consolepkg.NewGeneratedResources(r.Client, console, r.finalizerPrefix, log),
+		consolepkg.NewReferences(r.Client, console, log),
+		consolepkg.NewRoleBindings(r.Client, console, log),
+		consolepkg.NewKafkaSA(r.Client, r.Scheme, console, cluster, r.clusterDomain, r.AdminAPIClientFactory, r.finalizerPrefix, log),
+		consolepkg.NewKafkaACL(r.Client, r.Scheme, console, cluster, r.KafkaAdminClientFactory, r.finalizerPrefix, log),
 		configmapResource,
 		consolepkg.NewDeployment(r.Client, r.Scheme, console, cluster, r.Store, log),
+		consolepkg.NewHorizontalPodAutoscaler(r.Client, r.Scheme, console, log),
 		consolepkg.NewService(r.Client, r.Scheme, console, r.clusterDomain, log),
-		resources.NewIngress(r.Client, console, r.Scheme, subdomain, console.GetName(), consolepkg.ServicePortName, log).WithTLS(resources.LEClusterIssuer, fmt.Sprintf("%s-redpanda", cluster.GetName())),
+		resources.NewIngress(r.Client, console, r.Scheme, subdomain, console.GetName(), consolepkg.ServicePortName, log).
+			WithTLS(resources.LEClusterIssuer, fmt.Sprintf("%s-redpanda", cluster.GetName())).
+			WithOwnerReference(!console.Spec.DisableOwnerReferences),
 	}
 	for _, each := range applyResources {
 		if err := each.Ensure(ctx); err != nil { //nolint:gocritic // more readable
@@ -174,20 +204,62 @@ func (r *Reconciling) Do(
 				// Don't return the error, as it is most likely not an actual error
 				return ctrl.Result{Requeue: true}, nil
 			}
+			var ce *consolepkg.ConditionError
+			if errors.As(err, &ce) {
+				// ConditionError is fixed by the user, set the condition so it is visible in the Console status
+				if console.Status.SetCondition(ce.Type, corev1.ConditionTrue, ce.Reason, ce.Message) {
+					if updateErr := consolepkg.UpdateStatus(ctx, r.Client, console); updateErr != nil {
+						return ctrl.Result{}, updateErr
+					}
+				}
+			}
 			return ctrl.Result{}, err
 		}
 	}
 
-	if !console.GenerationMatchesObserved() {
-		console.Status.ObservedGeneration = console.GetGeneration()
-		if err := r.Status().Update(ctx, console); err != nil {
-			return ctrl.Result{}, err
-		}
+	// Resources may change status without updating it, e.g. ConfigMapRef or conditions
+	// The status is updated on every successful reconcile to record LastReconcileTime
+	console.Status.ObservedGeneration = r.generation
+	now := metav1.Now()
+	console.Status.LastReconcileTime = &now
+	if err := consolepkg.UpdateStatus(ctx, r.Client, console); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Deployment changes trigger reconcile but the grace period ending does not
+	if remaining := consolepkg.ReplicasUnavailableRemaining(console); remaining > 0 {
+		log.V(debugLogLevel).Info(fmt.Sprintf("Replicas unavailable, requeueing after %s", remaining))
+		return ctrl.Result{RequeueAfter: remaining}, nil
+	}
+
+	// Resolve SRV record again on schedule
+	if console.Spec.Kafka.SRVRecord != "" {
+		return ctrl.Result{RequeueAfter: consolepkg.GetSRVRefreshInterval(console)}, nil
 	}
 
 	return ctrl.Result{}, nil
 }
 
+// resolveBrokers resolves the Kafka SRV record and saves the brokers in status
+// If resolved brokers changed, ConfigMapRef is unset so a new ConfigMap is created
+func (r *Reconciling) resolveBrokers(
+	ctx context.Context, console *redpandav1alpha1.Console,
+) error {
+	if console.Spec.Kafka.SRVRecord == "" {
+		return nil
+	}
+	brokers, err := consolepkg.ResolveSRVBrokers(ctx, r.SRVResolver, console.Spec.Kafka.SRVRecord)
+	if err != nil {
+		return err
+	}
+	if reflect.DeepEqual(brokers, console.Status.ResolvedBrokers) {
+		return nil
+	}
+	console.Status.ResolvedBrokers = brokers
+	console.Status.ConfigMapRef = nil
+	return consolepkg.UpdateStatus(ctx, r.Client, console)
+}
+
 // Deleting is the state of the Console that handles deletion
 type Deleting ConsoleState
 
@@ -199,8 +271,9 @@ func (r *Deleting) Do(
 	log logr.Logger,
 ) (ctrl.Result, error) {
 	applyResources := []resources.ManagedResource{
-		consolepkg.NewKafkaSA(r.Client, r.Scheme, console, cluster, r.clusterDomain, r.AdminAPIClientFactory, log),
-		consolepkg.NewKafkaACL(r.Client, r.Scheme, console, cluster, r.KafkaAdminClientFactory, log),
+		consolepkg.NewKafkaSA(r.Client, r.Scheme, console, cluster, r.clusterDomain, r.AdminAPIClientFactory, r.finalizerPrefix, log),
+		consolepkg.NewKafkaACL(r.Client, r.Scheme, console, cluster, r.KafkaAdminClientFactory, r.finalizerPrefix, log),
+		consolepkg.NewGeneratedResources(r.Client, console, r.finalizerPrefix, log),
 	}
 
 	for _, each := range applyResources {
@@ -220,24 +293,73 @@ func (r *ConsoleReconciler) handleSpecChange(
 		// We are creating new ConfigMap for every spec change so Deployment can detect changes and redeploy Pods
 		// Unset Status.ConfigMapRef so we can delete the previous unused ConfigMap
 		console.Status.ConfigMapRef = nil
-		if err := r.Status().Update(ctx, console); err != nil {
+		if err := consolepkg.UpdateStatus(ctx, r.Client, console); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
+// ignoreLastReconcileTimeUpdate filters Console updates that only change Status.LastReconcileTime
+// Otherwise every successful reconcile would trigger another one
+func ignoreLastReconcileTimeUpdate() predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldConsole, ok := e.ObjectOld.(*redpandav1alpha1.Console)
+			if !ok {
+				return true
+			}
+			newConsole, ok := e.ObjectNew.(*redpandav1alpha1.Console)
+			if !ok {
+				return true
+			}
+			oldConsole, newConsole = oldConsole.DeepCopy(), newConsole.DeepCopy()
+			for _, c := range []*redpandav1alpha1.Console{oldConsole, newConsole} {
+				c.Status.LastReconcileTime = nil
+				c.SetResourceVersion("")
+				c.SetManagedFields(nil)
+			}
+			return !reflect.DeepEqual(oldConsole, newConsole)
+		},
+	}
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *ConsoleReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&redpandav1alpha1.Console{}).
+		For(&redpandav1alpha1.Console{}, builder.WithPredicates(ignoreLastReconcileTimeUpdate())).
 		Owns(&corev1.Secret{}).
 		Owns(&corev1.ServiceAccount{}).
 		Owns(&appsv1.Deployment{}).
+		Owns(&autoscalingv2beta2.HorizontalPodAutoscaler{}).
 		Owns(&corev1.Service{}).
+		WithOptions(r.ControllerOptions()).
 		Complete(r)
 }
 
+// ControllerOptions returns the options used to build the Console controller
+func (r *ConsoleReconciler) ControllerOptions() controller.Options {
+	return controller.Options{MaxConcurrentReconciles: r.maxConcurrentReconciles}
+}
+
+// WithMaxConcurrentReconciles sets the number of Consoles reconciled in parallel
+// If not set, Consoles are reconciled one at a time
+func (r *ConsoleReconciler) WithMaxConcurrentReconciles(
+	maxConcurrentReconciles int,
+) *ConsoleReconciler {
+	r.maxConcurrentReconciles = maxConcurrentReconciles
+	return r
+}
+
+// WithFinalizerPrefix sets the prefix of the finalizers added to Console
+// If not set, DefaultFinalizerPrefix is used
+func (r *ConsoleReconciler) WithFinalizerPrefix(
+	finalizerPrefix string,
+) *ConsoleReconciler {
+	r.finalizerPrefix = finalizerPrefix
+	return r
+}
+
 // WithClusterDomain sets the clusterDomain
 func (r *ConsoleReconciler) WithClusterDomain(
 	clusterDomain string,
@@ -205,6 +205,38 @@ var _ = Describe("Console controller", func() {
 				return updatedConfigmapNsn == configmapNsn
 			}, timeout, interval).Should(BeTrue())
 		})
+
+		It("Should advance LastReconcileTime on every reconcile", func() {
+			consoleLookupKey := types.NamespacedName{Name: ConsoleName, Namespace: ConsoleNamespace}
+			createdConsole := &redpandav1alpha1.Console{}
+			Eventually(func() bool {
+				if err := k8sClient.Get(ctx, consoleLookupKey, createdConsole); err != nil {
+					return false
+				}
+				return createdConsole.Status.LastReconcileTime != nil
+			}, timeout, interval).Should(BeTrue())
+			last := createdConsole.Status.LastReconcileTime.DeepCopy()
+
+			By("Triggering another reconcile")
+			// The timestamp has second precision
+			time.Sleep(time.Second)
+			Eventually(func() error {
+				if err := k8sClient.Get(ctx, consoleLookupKey, createdConsole); err != nil {
+					return err
+				}
+				createdConsole.SetAnnotations(map[string]string{"test.redpanda.vectorized.io/reconcile": "again"})
+				return k8sClient.Update(ctx, createdConsole)
+			}, timeout, interval).Should(Succeed())
+
+			Eventually(func() bool {
+				updatedConsole := &redpandav1alpha1.Console{}
+				if err := k8sClient.Get(ctx, consoleLookupKey, updatedConsole); err != nil {
+					return false
+				}
+				current := updatedConsole.Status.LastReconcileTime
+				return current != nil && last.Before(current)
+			}, timeout, interval).Should(BeTrue())
+		})
 	})
 
 	Context("When updating Console with Enterprise features", func() {
@@ -294,10 +326,12 @@ var _ = Describe("Console controller", func() {
 					RoleBindingsRef: corev1.LocalObjectReference{Name: rbacName},
 				},
 			}
-			console.Spec.LicenseRef = &redpandav1alpha1.SecretKeyRef{
-				Name:      licenseName,
-				Namespace: ConsoleNamespace,
-				Key:       licenseDataKey,
+			console.Spec.LicenseRef = &redpandav1alpha1.LicenseSecretKeyRef{
+				SecretKeyRef: redpandav1alpha1.SecretKeyRef{
+					Name:      licenseName,
+					Namespace: ConsoleNamespace,
+					Key:       licenseDataKey,
+				},
 			}
 			console.Spec.Login = &redpandav1alpha1.EnterpriseLogin{
 				Enabled: true,
@@ -387,4 +421,56 @@ var _ = Describe("Console controller", func() {
 			}, timeout, interval).Should(BeTrue())
 		})
 	})
+
+	Context("When reconciling multiple Consoles", func() {
+		ctx := context.Background()
+		It("Should reconcile each Console independently", func() {
+			By("Creating Consoles at once")
+			names := []string{"parallel-console-a", "parallel-console-b", "parallel-console-c"}
+			for _, name := range names {
+				console := &redpandav1alpha1.Console{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      name,
+						Namespace: ConsoleNamespace,
+					},
+					Spec: redpandav1alpha1.ConsoleSpec{
+						ClusterRef: redpandav1alpha1.NamespaceNameRef{Namespace: ConsoleNamespace, Name: ClusterName},
+						Deployment: redpandav1alpha1.Deployment{Image: "vectorized/console:latest"},
+					},
+				}
+				Expect(k8sClient.Create(ctx, console)).Should(Succeed())
+			}
+
+			By("Having the ConfigMap and Deployment of each Console")
+			for _, name := range names {
+				consoleLookupKey := types.NamespacedName{Name: name, Namespace: ConsoleNamespace}
+				Eventually(func() bool {
+					console := &redpandav1alpha1.Console{}
+					if err := k8sClient.Get(ctx, consoleLookupKey, console); err != nil {
+						return false
+					}
+					ref := console.Status.ConfigMapRef
+					if ref == nil {
+						return false
+					}
+					configmaps := &corev1.ConfigMapList{}
+					if err := k8sClient.List(ctx, configmaps, client.MatchingLabels(labels.ForConsole(console)), client.InNamespace(ConsoleNamespace)); err != nil {
+						return false
+					}
+					if len(configmaps.Items) != 1 || configmaps.Items[0].GetName() != ref.Name {
+						return false
+					}
+					cc := &consolepkg.ConsoleConfig{}
+					if err := yaml.Unmarshal([]byte(configmaps.Items[0].Data["config.yaml"]), cc); err != nil {
+						return false
+					}
+					// Config generated for another Console would have a different client ID
+					if cc.Kafka.ClientID != fmt.Sprintf("redpanda-console-%s-%s", ConsoleNamespace, name) {
+						return false
+					}
+					return k8sClient.Get(ctx, consoleLookupKey, &appsv1.Deployment{}) == nil
+				}, timeout, interval).Should(BeTrue())
+			}
+		})
+	})
 })
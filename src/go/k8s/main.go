@@ -10,6 +10,7 @@ package main
 
 import (
 	"flag"
+	"net"
 	"os"
 	"time"
 
@@ -48,18 +49,25 @@ func init() {
 	//+kubebuilder:scaffold:scheme
 }
 
+// bindConsoleMaxConcurrentReconciles registers the flag setting the number of Consoles reconciled in parallel
+func bindConsoleMaxConcurrentReconciles(fs *flag.FlagSet, p *int) {
+	fs.IntVar(p, "console-max-concurrent-reconciles", 1, "Set the number of Consoles reconciled in parallel")
+}
+
 //nolint:funlen // length looks good
 func main() {
 	var (
-		clusterDomain               string
-		metricsAddr                 string
-		enableLeaderElection        bool
-		probeAddr                   string
-		webhookEnabled              bool
-		configuratorBaseImage       string
-		configuratorTag             string
-		configuratorImagePullPolicy string
-		decommissionWaitInterval    time.Duration
+		clusterDomain                  string
+		metricsAddr                    string
+		enableLeaderElection           bool
+		probeAddr                      string
+		webhookEnabled                 bool
+		configuratorBaseImage          string
+		configuratorTag                string
+		configuratorImagePullPolicy    string
+		decommissionWaitInterval       time.Duration
+		consoleFinalizerPrefix         string
+		consoleMaxConcurrentReconciles int
 	)
 
 	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8080", "The address the metric endpoint binds to.")
@@ -75,6 +83,9 @@ func main() {
 	flag.DurationVar(&decommissionWaitInterval, "decommission-wait-interval", 8*time.Second, "Set the time to wait for a node decommission to happen in the cluster")
 	flag.BoolVar(&redpandav1alpha1.AllowDownscalingInWebhook, "allow-downscaling", false, "Allow to reduce the number of replicas in existing clusters (alpha feature)")
 	flag.BoolVar(&redpandav1alpha1.AllowConsoleAnyNamespace, "allow-console-any-ns", false, "Allow to create Console in any namespace. Allowing this copies Redpanda SchemaRegistry TLS Secret to namespace (alpha feature)")
+	bindConsoleMaxConcurrentReconciles(flag.CommandLine, &consoleMaxConcurrentReconciles)
+	flag.StringVar(&consolepkg.DefaultImagePullSecret, "default-image-pull-secret", "", "Set the image pull secret added to the pods of all Consoles, the Secret must exist in the Console namespace")
+	flag.StringVar(&consoleFinalizerPrefix, "console-finalizer-prefix", consolepkg.DefaultFinalizerPrefix, "Set the prefix of the finalizers added to Console, e.g. to run multiple operators without finalizer collisions")
 
 	opts := zap.Options{
 		Development: true,
@@ -152,7 +163,8 @@ func main() {
 		Store:                   consolepkg.NewStore(mgr.GetClient()),
 		EventRecorder:           mgr.GetEventRecorderFor("Console"),
 		KafkaAdminClientFactory: consolepkg.NewKafkaAdmin,
-	}).WithClusterDomain(clusterDomain).SetupWithManager(mgr); err != nil {
+		SRVResolver:             net.DefaultResolver,
+	}).WithClusterDomain(clusterDomain).WithMaxConcurrentReconciles(consoleMaxConcurrentReconciles).WithFinalizerPrefix(consoleFinalizerPrefix).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Console")
 		os.Exit(1)
 	}
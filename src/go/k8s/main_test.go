@@ -0,0 +1,41 @@
+// Copyright 2022 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package main
+
+import (
+	"flag"
+	"testing"
+
+	redpandacontrollers "github.com/redpanda-data/redpanda/src/go/k8s/controllers/redpanda"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConsoleMaxConcurrentReconciles(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		expected int
+	}{
+		{"default", nil, 1},
+		{"set", []string{"--console-max-concurrent-reconciles=4"}, 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var maxConcurrentReconciles int
+			fs := flag.NewFlagSet("test", flag.ContinueOnError)
+			bindConsoleMaxConcurrentReconciles(fs, &maxConcurrentReconciles)
+			require.NoError(t, fs.Parse(tt.args))
+
+			reconciler := (&redpandacontrollers.ConsoleReconciler{}).WithMaxConcurrentReconciles(maxConcurrentReconciles)
+			assert.Equal(t, tt.expected, reconciler.ControllerOptions().MaxConcurrentReconciles)
+		})
+	}
+}
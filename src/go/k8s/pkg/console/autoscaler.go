@@ -0,0 +1,142 @@
+package console
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	redpandav1alpha1 "github.com/redpanda-data/redpanda/src/go/k8s/apis/redpanda/v1alpha1"
+	labels "github.com/redpanda-data/redpanda/src/go/k8s/pkg/labels"
+	"github.com/redpanda-data/redpanda/src/go/k8s/pkg/resources"
+	autoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8slabels "k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// HorizontalPodAutoscaler is a Console resource
+type HorizontalPodAutoscaler struct {
+	client.Client
+	scheme     *runtime.Scheme
+	consoleobj *redpandav1alpha1.Console
+	log        logr.Logger
+}
+
+// NewHorizontalPodAutoscaler instantiates a new HorizontalPodAutoscaler
+func NewHorizontalPodAutoscaler(
+	cl client.Client,
+	scheme *runtime.Scheme,
+	consoleobj *redpandav1alpha1.Console,
+	log logr.Logger,
+) *HorizontalPodAutoscaler {
+	return &HorizontalPodAutoscaler{
+		Client:     cl,
+		scheme:     scheme,
+		consoleobj: consoleobj,
+		log:        log,
+	}
+}
+
+// Ensure implements Resource interface
+// The HorizontalPodAutoscaler is deleted if autoscaling is disabled
+func (h *HorizontalPodAutoscaler) Ensure(ctx context.Context) error {
+	if !h.consoleobj.IsAutoscalingEnabled() {
+		return h.deleteIfOwned(ctx)
+	}
+
+	autoscaling := h.consoleobj.Spec.Deployment.Autoscaling
+	minReplicas := autoscaling.MinReplicas
+	obj := &autoscalingv2beta2.HorizontalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      h.Key().Name,
+			Namespace: h.Key().Namespace,
+			Labels:    labels.ForConsole(h.consoleobj),
+		},
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "HorizontalPodAutoscaler",
+			APIVersion: "autoscaling/v2beta2",
+		},
+		Spec: autoscalingv2beta2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2beta2.CrossVersionObjectReference{
+				Kind:       "Deployment",
+				Name:       h.consoleobj.GetName(),
+				APIVersion: "apps/v1",
+			},
+			MinReplicas: &minReplicas,
+			MaxReplicas: autoscaling.MaxReplicas,
+			Metrics: []autoscalingv2beta2.MetricSpec{
+				{
+					Type: autoscalingv2beta2.ResourceMetricSourceType,
+					Resource: &autoscalingv2beta2.ResourceMetricSource{
+						Name: corev1.ResourceCPU,
+						Target: autoscalingv2beta2.MetricTarget{
+							Type:               autoscalingv2beta2.UtilizationMetricType,
+							AverageUtilization: &autoscaling.TargetCPUUtilization,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	setHelmAnnotations(h.consoleobj, obj)
+	if err := setOwnerReference(h.consoleobj, obj, h.scheme); err != nil {
+		return err
+	}
+
+	created, err := resources.CreateIfNotExists(ctx, h.Client, obj, h.log)
+	if err != nil {
+		return fmt.Errorf("creating Console horizontalpodautoscaler: %w", err)
+	}
+
+	if !created {
+		var current autoscalingv2beta2.HorizontalPodAutoscaler
+		if err := h.Get(ctx, h.Key(), &current); err != nil {
+			return fmt.Errorf("fetching Console horizontalpodautoscaler: %w", err)
+		}
+		if _, err := resources.Update(ctx, &current, obj, h.Client, h.log); err != nil {
+			return fmt.Errorf("updating Console horizontalpodautoscaler: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// deleteIfOwned deletes the HorizontalPodAutoscaler if it exists and was created for this Console
+// The read is served from the cache, so disabled autoscaling doesn't issue a delete every reconcile
+func (h *HorizontalPodAutoscaler) deleteIfOwned(ctx context.Context) error {
+	obj := &autoscalingv2beta2.HorizontalPodAutoscaler{}
+	if err := h.Get(ctx, h.Key(), obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("fetching Console horizontalpodautoscaler: %w", err)
+	}
+	if !h.isOwned(obj) {
+		h.log.Info("Not deleting HorizontalPodAutoscaler that is not owned by Console", "name", h.Key())
+		return nil
+	}
+	if err := h.Delete(ctx, obj); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("deleting Console horizontalpodautoscaler: %w", err)
+	}
+	return nil
+}
+
+// isOwned returns true if the HorizontalPodAutoscaler is controlled by this Console
+// Without owner references, the Console selector labels identify it
+func (h *HorizontalPodAutoscaler) isOwned(obj *autoscalingv2beta2.HorizontalPodAutoscaler) bool {
+	if metav1.IsControlledBy(obj, h.consoleobj) {
+		return true
+	}
+	return h.consoleobj.Spec.DisableOwnerReferences &&
+		labels.ForConsole(h.consoleobj).AsClientSelector().Matches(k8slabels.Set(obj.GetLabels()))
+}
+
+// Key implements Resource interface
+func (h *HorizontalPodAutoscaler) Key() types.NamespacedName {
+	return types.NamespacedName{Name: h.consoleobj.GetName(), Namespace: h.consoleobj.GetNamespace()}
+}
@@ -0,0 +1,81 @@
+// Copyright 2022 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package console_test
+
+import (
+	"context"
+	"testing"
+
+	redpandav1alpha1 "github.com/redpanda-data/redpanda/src/go/k8s/apis/redpanda/v1alpha1"
+	"github.com/redpanda-data/redpanda/src/go/k8s/pkg/console"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestEnsureHorizontalPodAutoscaler(t *testing.T) {
+	require.NoError(t, redpandav1alpha1.AddToScheme(scheme.Scheme))
+	ctx := context.Background()
+	log := ctrl.Log.WithName("test")
+
+	consoleobj := testConsole()
+	consoleobj.Spec.Deployment.Autoscaling = &redpandav1alpha1.Autoscaling{
+		Enabled:              true,
+		MinReplicas:          2,
+		MaxReplicas:          5,
+		TargetCPUUtilization: 70,
+	}
+	cluster := testCluster()
+
+	c := fake.NewClientBuilder().Build()
+	require.NoError(t, c.Create(ctx, consoleobj))
+	require.NoError(t, console.NewDeployment(c, scheme.Scheme, consoleobj, cluster, console.NewStore(c), log).Ensure(ctx))
+	require.NoError(t, console.NewHorizontalPodAutoscaler(c, scheme.Scheme, consoleobj, log).Ensure(ctx))
+
+	key := client.ObjectKeyFromObject(consoleobj)
+	deployment := &appsv1.Deployment{}
+	require.NoError(t, c.Get(ctx, key, deployment))
+	assert.Nil(t, deployment.Spec.Replicas)
+
+	hpa := &autoscalingv2beta2.HorizontalPodAutoscaler{}
+	require.NoError(t, c.Get(ctx, key, hpa))
+	assert.Equal(t, "Deployment", hpa.Spec.ScaleTargetRef.Kind)
+	assert.Equal(t, consoleobj.GetName(), hpa.Spec.ScaleTargetRef.Name)
+	require.NotNil(t, hpa.Spec.MinReplicas)
+	assert.Equal(t, int32(2), *hpa.Spec.MinReplicas)
+	assert.Equal(t, int32(5), hpa.Spec.MaxReplicas)
+	require.Len(t, hpa.Spec.Metrics, 1)
+	assert.Equal(t, corev1.ResourceCPU, hpa.Spec.Metrics[0].Resource.Name)
+	assert.Equal(t, int32(70), *hpa.Spec.Metrics[0].Resource.Target.AverageUtilization)
+
+	// Disabling autoscaling deletes the HorizontalPodAutoscaler
+	consoleobj.Spec.Deployment.Autoscaling.Enabled = false
+	require.NoError(t, console.NewHorizontalPodAutoscaler(c, scheme.Scheme, consoleobj, log).Ensure(ctx))
+	assert.True(t, apierrors.IsNotFound(c.Get(ctx, key, &autoscalingv2beta2.HorizontalPodAutoscaler{})))
+
+	// Missing HorizontalPodAutoscaler is not an error
+	require.NoError(t, console.NewHorizontalPodAutoscaler(c, scheme.Scheme, consoleobj, log).Ensure(ctx))
+
+	// HorizontalPodAutoscaler not owned by Console is kept
+	require.NoError(t, c.Create(ctx, &autoscalingv2beta2.HorizontalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace},
+		Spec:       autoscalingv2beta2.HorizontalPodAutoscalerSpec{MaxReplicas: 3},
+	}))
+	require.NoError(t, console.NewHorizontalPodAutoscaler(c, scheme.Scheme, consoleobj, log).Ensure(ctx))
+	require.NoError(t, c.Get(ctx, key, &autoscalingv2beta2.HorizontalPodAutoscaler{}))
+}
@@ -0,0 +1,157 @@
+package console
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	redpandav1alpha1 "github.com/redpanda-data/redpanda/src/go/k8s/apis/redpanda/v1alpha1"
+	labels "github.com/redpanda-data/redpanda/src/go/k8s/pkg/labels"
+	v1 "k8s.io/api/apps/v1"
+	autoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
+	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// setOwnerReference sets Console as the controller of the object unless owner references are disabled
+func setOwnerReference(
+	consoleobj *redpandav1alpha1.Console, obj metav1.Object, scheme *runtime.Scheme,
+) error {
+	if consoleobj.Spec.DisableOwnerReferences {
+		return nil
+	}
+	return controllerutil.SetControllerReference(consoleobj, obj, scheme)
+}
+
+// Annotations that Helm requires to adopt existing resources into a release
+const (
+	HelmReleaseNameAnnotation      = "meta.helm.sh/release-name"
+	HelmReleaseNamespaceAnnotation = "meta.helm.sh/release-namespace"
+	HelmManagedByAnnotation        = "app.kubernetes.io/managed-by"
+	helmManagedByVal               = "Helm"
+)
+
+// setHelmAnnotations stamps the Helm release annotations on the object if a Helm release is configured
+func setHelmAnnotations(consoleobj *redpandav1alpha1.Console, obj metav1.Object) {
+	release := consoleobj.Spec.HelmRelease
+	if release == nil {
+		return
+	}
+	namespace := release.Namespace
+	if namespace == "" {
+		namespace = consoleobj.GetNamespace()
+	}
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[HelmReleaseNameAnnotation] = release.Name
+	annotations[HelmReleaseNamespaceAnnotation] = namespace
+	annotations[HelmManagedByAnnotation] = helmManagedByVal
+	obj.SetAnnotations(annotations)
+}
+
+// GeneratedResources is a Console resource
+// It deletes resources generated for Console if these are not garbage collected via owner references
+type GeneratedResources struct {
+	client.Client
+	consoleobj      *redpandav1alpha1.Console
+	finalizerPrefix string
+	log             logr.Logger
+}
+
+// NewGeneratedResources instantiates a new GeneratedResources
+func NewGeneratedResources(
+	cl client.Client,
+	consoleobj *redpandav1alpha1.Console,
+	finalizerPrefix string,
+	log logr.Logger,
+) *GeneratedResources {
+	return &GeneratedResources{
+		Client:          cl,
+		consoleobj:      consoleobj,
+		finalizerPrefix: finalizerPrefix,
+		log:             log,
+	}
+}
+
+// Ensure implements Resource interface
+// Adds the cleanup finalizer if owner references are disabled, removes it otherwise
+func (g *GeneratedResources) Ensure(ctx context.Context) error {
+	disabled := g.consoleobj.Spec.DisableOwnerReferences
+	finalizer := Finalizer(g.finalizerPrefix, ConsoleCleanupFinalizer)
+	switch {
+	case disabled && !controllerutil.ContainsFinalizer(g.consoleobj, finalizer):
+		controllerutil.AddFinalizer(g.consoleobj, finalizer)
+	case !disabled && containsFinalizer(g.consoleobj, g.finalizerPrefix, ConsoleCleanupFinalizer):
+		removeFinalizer(g.consoleobj, g.finalizerPrefix, ConsoleCleanupFinalizer)
+	default:
+		return nil
+	}
+	return g.Update(ctx, g.consoleobj)
+}
+
+// Key implements Resource interface
+// But this is not a single K8s resource, not implemented
+func (g *GeneratedResources) Key() (nsn types.NamespacedName) {
+	return nsn
+}
+
+// Cleanup implements ManagedResource interface
+func (g *GeneratedResources) Cleanup(ctx context.Context) error {
+	if !containsFinalizer(g.consoleobj, g.finalizerPrefix, ConsoleCleanupFinalizer) {
+		return nil
+	}
+
+	key := types.NamespacedName{Name: g.consoleobj.GetName(), Namespace: g.consoleobj.GetNamespace()}
+	named := []client.Object{
+		&v1.Deployment{},
+		&corev1.Service{},
+		&corev1.ServiceAccount{},
+		&netv1.Ingress{},
+		&autoscalingv2beta2.HorizontalPodAutoscaler{},
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: KafkaSASecretKey(g.consoleobj).Name}},
+	}
+	for _, obj := range named {
+		if obj.GetName() == "" {
+			obj.SetName(key.Name)
+		}
+		obj.SetNamespace(key.Namespace)
+		if err := g.Delete(ctx, obj); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("deleting %T %s: %w", obj, obj.GetName(), err)
+		}
+	}
+
+	// ConfigMaps and synced Secrets are found by labels
+	opts := []client.ListOption{
+		client.MatchingLabels(labels.ForConsole(g.consoleobj)),
+		client.InNamespace(key.Namespace),
+	}
+	cms := &corev1.ConfigMapList{}
+	if err := g.List(ctx, cms, opts...); err != nil {
+		return fmt.Errorf("listing Console configmaps: %w", err)
+	}
+	for i := range cms.Items {
+		if err := g.Delete(ctx, &cms.Items[i]); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("deleting Console configmap %s: %w", cms.Items[i].GetName(), err)
+		}
+	}
+	secrets := &corev1.SecretList{}
+	if err := g.List(ctx, secrets, opts...); err != nil {
+		return fmt.Errorf("listing Console secrets: %w", err)
+	}
+	for i := range secrets.Items {
+		if err := g.Delete(ctx, &secrets.Items[i]); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("deleting Console secret %s: %w", secrets.Items[i].GetName(), err)
+		}
+	}
+
+	removeFinalizer(g.consoleobj, g.finalizerPrefix, ConsoleCleanupFinalizer)
+	return g.Update(ctx, g.consoleobj)
+}
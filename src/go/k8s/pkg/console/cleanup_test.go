@@ -0,0 +1,138 @@
+// Copyright 2022 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package console_test
+
+import (
+	"context"
+	"testing"
+
+	redpandav1alpha1 "github.com/redpanda-data/redpanda/src/go/k8s/apis/redpanda/v1alpha1"
+	"github.com/redpanda-data/redpanda/src/go/k8s/pkg/console"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+func TestGeneratedResources_DisableOwnerReferences(t *testing.T) {
+	require.NoError(t, redpandav1alpha1.AddToScheme(scheme.Scheme))
+	ctx := context.Background()
+	log := ctrl.Log.WithName("test")
+
+	consoleobj := testConsole()
+	consoleobj.Spec.DisableOwnerReferences = true
+	cluster := testCluster()
+
+	c := fake.NewClientBuilder().Build()
+	require.NoError(t, c.Create(ctx, consoleobj))
+
+	generated := console.NewGeneratedResources(c, consoleobj, console.DefaultFinalizerPrefix, log)
+	require.NoError(t, generated.Ensure(ctx))
+	assert.True(t, controllerutil.ContainsFinalizer(consoleobj, console.ConsoleCleanupFinalizer))
+
+	ensureConfig(t, c, consoleobj, cluster)
+	require.NoError(t, console.NewDeployment(c, scheme.Scheme, consoleobj, cluster, console.NewStore(c), log).Ensure(ctx))
+	require.NoError(t, console.NewService(c, scheme.Scheme, consoleobj, "cluster.local", log).Ensure(ctx))
+
+	key := client.ObjectKeyFromObject(consoleobj)
+	objs := []client.Object{&appsv1.Deployment{}, &corev1.Service{}, &corev1.ServiceAccount{}}
+	for _, obj := range objs {
+		require.NoError(t, c.Get(ctx, key, obj))
+		assert.Empty(t, obj.GetOwnerReferences(), "%T", obj)
+	}
+	cms := &corev1.ConfigMapList{}
+	require.NoError(t, c.List(ctx, cms, client.InNamespace(consoleobj.GetNamespace())))
+	require.Len(t, cms.Items, 1)
+	assert.Empty(t, cms.Items[0].GetOwnerReferences())
+
+	require.NoError(t, generated.Cleanup(ctx))
+	for _, obj := range objs {
+		assert.True(t, apierrors.IsNotFound(c.Get(ctx, key, obj)), "%T", obj)
+	}
+	require.NoError(t, c.List(ctx, cms, client.InNamespace(consoleobj.GetNamespace())))
+	assert.Empty(t, cms.Items)
+	assert.False(t, controllerutil.ContainsFinalizer(consoleobj, console.ConsoleCleanupFinalizer))
+}
+
+func TestGeneratedResources_FinalizerPrefix(t *testing.T) {
+	require.NoError(t, redpandav1alpha1.AddToScheme(scheme.Scheme))
+	ctx := context.Background()
+	log := ctrl.Log.WithName("test")
+	assert.Equal(t, "consoles.example.com/cleanup", console.Finalizer("consoles.example.com", console.ConsoleCleanupFinalizer))
+
+	consoleobj := testConsole()
+	consoleobj.Spec.DisableOwnerReferences = true
+	// Finalizer added before the prefix was set and a finalizer not managed by the operator
+	consoleobj.SetFinalizers([]string{console.ConsoleCleanupFinalizer, "example.com/other"})
+
+	c := fake.NewClientBuilder().Build()
+	require.NoError(t, c.Create(ctx, consoleobj))
+
+	generated := console.NewGeneratedResources(c, consoleobj, "consoles.example.com", log)
+	require.NoError(t, generated.Ensure(ctx))
+	assert.Equal(t,
+		[]string{console.ConsoleCleanupFinalizer, "example.com/other", "consoles.example.com/cleanup"},
+		consoleobj.GetFinalizers(),
+	)
+
+	// Default finalizer doesn't block deletion
+	require.NoError(t, generated.Cleanup(ctx))
+	assert.Equal(t, []string{"example.com/other"}, consoleobj.GetFinalizers())
+
+	// Console with only the default finalizer is cleaned up
+	ensureConfig(t, c, consoleobj, testCluster())
+	consoleobj.SetFinalizers([]string{console.ConsoleCleanupFinalizer})
+	require.NoError(t, c.Update(ctx, consoleobj))
+	require.NoError(t, generated.Cleanup(ctx))
+	assert.Empty(t, consoleobj.GetFinalizers())
+	cms := &corev1.ConfigMapList{}
+	require.NoError(t, c.List(ctx, cms, client.InNamespace(consoleobj.GetNamespace())))
+	assert.Empty(t, cms.Items)
+}
+
+func TestGeneratedResources_HelmAnnotations(t *testing.T) {
+	require.NoError(t, redpandav1alpha1.AddToScheme(scheme.Scheme))
+	ctx := context.Background()
+	log := ctrl.Log.WithName("test")
+
+	consoleobj := testConsole()
+	consoleobj.Spec.HelmRelease = &redpandav1alpha1.HelmRelease{Name: "console"}
+	cluster := testCluster()
+
+	c := fake.NewClientBuilder().Build()
+	require.NoError(t, c.Create(ctx, consoleobj))
+
+	ensureConfig(t, c, consoleobj, cluster)
+	require.NoError(t, console.NewDeployment(c, scheme.Scheme, consoleobj, cluster, console.NewStore(c), log).Ensure(ctx))
+	require.NoError(t, console.NewService(c, scheme.Scheme, consoleobj, "cluster.local", log).Ensure(ctx))
+
+	expected := map[string]string{
+		console.HelmReleaseNameAnnotation:      "console",
+		console.HelmReleaseNamespaceAnnotation: consoleobj.GetNamespace(),
+		console.HelmManagedByAnnotation:        "Helm",
+	}
+	key := client.ObjectKeyFromObject(consoleobj)
+	for _, obj := range []client.Object{&appsv1.Deployment{}, &corev1.Service{}, &corev1.ServiceAccount{}} {
+		require.NoError(t, c.Get(ctx, key, obj))
+		for k, v := range expected {
+			assert.Equal(t, v, obj.GetAnnotations()[k], "%T %s", obj, k)
+		}
+	}
+	cms := &corev1.ConfigMapList{}
+	require.NoError(t, c.List(ctx, cms, client.InNamespace(consoleobj.GetNamespace())))
+	require.Len(t, cms.Items, 1)
+	assert.Equal(t, "console", cms.Items[0].GetAnnotations()[console.HelmReleaseNameAnnotation])
+}
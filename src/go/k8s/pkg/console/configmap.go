@@ -2,25 +2,37 @@ package console
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"net"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/cloudhut/common/rest"
 	"github.com/go-logr/logr"
 	"github.com/redpanda-data/console/backend/pkg/connect"
 	"github.com/redpanda-data/console/backend/pkg/kafka"
+	"github.com/redpanda-data/console/backend/pkg/proto"
 	"github.com/redpanda-data/console/backend/pkg/schema"
 	redpandav1alpha1 "github.com/redpanda-data/redpanda/src/go/k8s/apis/redpanda/v1alpha1"
 	labels "github.com/redpanda-data/redpanda/src/go/k8s/pkg/labels"
+	"github.com/redpanda-data/redpanda/src/go/k8s/pkg/resources/certmanager"
 	"github.com/redpanda-data/redpanda/src/go/rpk/pkg/api/admin"
 	"gopkg.in/yaml.v2"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 )
 
 // ConfigMap is a Console resource
@@ -59,7 +71,7 @@ func (cm *ConfigMap) Ensure(ctx context.Context) error {
 	// This check is not necessary but it's an additional safeguard to make sure ConfigMaps are not more than expected
 	if err := cm.isConfigMapDeleted(ctx); err != nil {
 		if errors.Is(err, ErrMultipleConfigMap) {
-			if deleteErr := cm.delete(ctx, ""); deleteErr != nil {
+			if deleteErr := cm.delete(ctx, nil); deleteErr != nil {
 				return fmt.Errorf("cannot delete all unused ConfigMaps: %w", deleteErr)
 			}
 		}
@@ -71,44 +83,137 @@ func (cm *ConfigMap) Ensure(ctx context.Context) error {
 	// But unused ConfigMaps should be deleted at the beginning of reconciliation via DeleteUnused()
 
 	secret := corev1.Secret{}
-	if err := cm.Get(ctx, KafkaSASecretKey(cm.consoleobj), &secret); err != nil {
+	if err := cm.Get(ctx, cm.kafkaCredentialsKey(), &secret); err != nil {
 		return err
 	}
-	username := string(secret.Data[corev1.BasicAuthUsernameKey])
-	password := string(secret.Data[corev1.BasicAuthPasswordKey])
-
-	config, err := cm.generateConsoleConfig(ctx, username, password)
+	config, err := cm.generateConsoleConfig(ctx, &secret)
 	if err != nil {
 		return err
 	}
+	// Console refuses to start with unknown keys, the running Pods keep the previous config
+	if err := checkSupportedConfig(config); err != nil {
+		return err
+	}
+	cm.setConfigSupportedCondition()
+	cm.setLicenseCondition()
 	cm.log.V(debugLogLevel).Info("Creating new ConfigMap", "data", config)
 
 	// Create new ConfigMap instead of updating existing so Deployment will trigger a reconcile
+	hash := configHash(config)
+	obj, kind := cm.configObject(config, hash)
+	setHelmAnnotations(cm.consoleobj, obj)
+	if err := setOwnerReference(cm.consoleobj, obj, cm.scheme); err != nil {
+		return err
+	}
+	if err := cm.Create(ctx, obj); err != nil {
+		return fmt.Errorf("creating Console config %s: %w", strings.ToLower(kind), err)
+	}
+
+	// This will get updated in the controller main reconcile function
+	// Other Resources may set Console status if they are also watching GenerationMatchesObserved()
+	cm.consoleobj.Status.ConfigMapRef = &corev1.ObjectReference{Kind: kind, Namespace: obj.GetNamespace(), Name: obj.GetName()}
+	cm.consoleobj.Status.ConfigHash = hash
+
+	return nil
+}
+
+const (
+	// ConfigSecretLabelKey is set on the Secrets that contain the Console config
+	// Other Secrets with Console labels, e.g. synced Schema Registry certificates, are not config
+	ConfigSecretLabelKey = "redpanda.vectorized.io/console-config"
+
+	// ConfigHashLabelKey is set on the ConfigMaps and Secrets that contain the Console config
+	// The value is the hash of the config, the hash of the config in use is reported in Status.ConfigHash
+	ConfigHashLabelKey = "console.redpanda.vectorized.io/config-hash"
+
+	configKindConfigMap = "ConfigMap"
+	configKindSecret    = "Secret"
+)
+
+// configHash returns the hash of the config
+// The SHA-256 sum is truncated to fit the 63 characters limit of label values
+func configHash(config string) string {
+	sum := sha256.Sum256([]byte(config))
+	return hex.EncodeToString(sum[:16])
+}
+
+// configObject returns the immutable ConfigMap, or Secret if Deployment.ConfigInSecret is set, that contains the config
+func (cm *ConfigMap) configObject(config, hash string) (client.Object, string) {
 	immutable := true
-	obj := &corev1.ConfigMap{
-		ObjectMeta: metav1.ObjectMeta{
-			GenerateName: cm.consoleobj.GetName() + "-",
-			Namespace:    cm.consoleobj.GetNamespace(),
-			Labels:       labels.ForConsole(cm.consoleobj),
-		},
+	meta := metav1.ObjectMeta{
+		GenerateName: cm.consoleobj.GetName() + "-",
+		Namespace:    cm.consoleobj.GetNamespace(),
+	}
+	if cm.consoleobj.Spec.Deployment.ConfigInSecret {
+		meta.Labels = cm.configSecretLabels()
+		meta.Labels[ConfigHashLabelKey] = hash
+		return &corev1.Secret{
+			ObjectMeta: meta,
+			Data: map[string][]byte{
+				"config.yaml": []byte(config),
+			},
+			Immutable: &immutable,
+		}, configKindSecret
+	}
+	meta.Labels = map[string]string{ConfigHashLabelKey: hash}
+	for k, v := range labels.ForConsole(cm.consoleobj) {
+		meta.Labels[k] = v
+	}
+	return &corev1.ConfigMap{
+		ObjectMeta: meta,
 		Data: map[string]string{
 			"config.yaml": config,
 		},
 		Immutable: &immutable,
-	}
+	}, configKindConfigMap
+}
 
-	if err := controllerutil.SetControllerReference(cm.consoleobj, obj, cm.scheme); err != nil {
-		return err
+// configSecretLabels returns the labels of the config Secrets
+// Copies the Console labels to not modify the Console object
+func (cm *ConfigMap) configSecretLabels() map[string]string {
+	l := map[string]string{ConfigSecretLabelKey: "true"}
+	for k, v := range labels.ForConsole(cm.consoleobj) {
+		l[k] = v
 	}
-	if err := cm.Create(ctx, obj); err != nil {
-		return fmt.Errorf("creating Console configmap: %w", err)
+	return l
+}
+
+// setConfigSupportedCondition clears the ConfigUnsupported condition set by the controller for a previous config
+func (cm *ConfigMap) setConfigSupportedCondition() {
+	if cm.consoleobj.Status.GetCondition(redpandav1alpha1.ConfigUnsupportedConditionType) == nil {
+		return
 	}
+	cm.consoleobj.Status.SetCondition(
+		redpandav1alpha1.ConfigUnsupportedConditionType, corev1.ConditionFalse,
+		redpandav1alpha1.ConfigUnsupportedReasonSupported, "",
+	)
+}
 
-	// This will get updated in the controller main reconcile function
-	// Other Resources may set Console status if they are also watching GenerationMatchesObserved()
-	cm.consoleobj.Status.ConfigMapRef = &corev1.ObjectReference{Namespace: obj.GetNamespace(), Name: obj.GetName()}
+// setLicenseCondition sets the LicenseOffline condition if license is provided
+func (cm *ConfigMap) setLicenseCondition() {
+	if cm.consoleobj.Spec.LicenseRef == nil {
+		return
+	}
+	if cm.consoleobj.Spec.LicenseOffline {
+		cm.consoleobj.Status.SetCondition(
+			redpandav1alpha1.LicenseOfflineConditionType, corev1.ConditionTrue,
+			redpandav1alpha1.LicenseOfflineReasonOffline, "License is an offline license for air-gapped environments",
+		)
+		return
+	}
+	cm.consoleobj.Status.SetCondition(
+		redpandav1alpha1.LicenseOfflineConditionType, corev1.ConditionFalse,
+		redpandav1alpha1.LicenseOfflineReasonOnline, "",
+	)
+}
 
-	return nil
+// kafkaCredentialsKey returns the Secret with Kafka SASL credentials
+// Uses the existing credentials if set, otherwise the generated SCRAM user
+func (cm *ConfigMap) kafkaCredentialsKey() types.NamespacedName {
+	if sasl := cm.consoleobj.Spec.Kafka.SASL; sasl != nil {
+		return types.NamespacedName{Namespace: sasl.CredentialsRef.Namespace, Name: sasl.CredentialsRef.Name}
+	}
+	return KafkaSASecretKey(cm.consoleobj)
 }
 
 // Key implements Resource interface
@@ -120,14 +225,80 @@ func (cm *ConfigMap) Key() types.NamespacedName {
 // This should match the fields at https://github.com/redpanda-data/console/blob/master/docs/config/console.yaml
 // We are copying the fields instead of importing them because (1) they don't have json tags (2) some fields aren't ideal for K8s (e.g. TLS certs shouldn't be file paths but Secret reference)
 func (cm *ConfigMap) generateConsoleConfig(
-	ctx context.Context, username, password string,
+	ctx context.Context, kafkaCredentials *corev1.Secret,
 ) (configString string, err error) {
 	consoleConfig := &ConsoleConfig{
 		MetricsNamespace: cm.consoleobj.Spec.MetricsPrefix,
 		ServeFrontend:    cm.consoleobj.Spec.ServeFrontend,
 		Server:           cm.genServer(),
-		Kafka:            cm.genKafka(username, password),
+		Kafka:            cm.genKafka(kafkaCredentials),
 		Enterprise:       cm.genEnterprise(),
+		Console: ConsoleSettings{
+			MaxMessagesPerFetch: cm.consoleobj.Spec.Console.MaxMessagesPerFetch,
+			Branding:            cm.genBranding(),
+			DisableTelemetry:    cm.consoleobj.Spec.Console.DisableTelemetry,
+		},
+		Authorization: Authorization{
+			SchemaRegistry: AuthorizationSchemaRegistry{
+				AllowSubjectDeletion: cm.consoleobj.Spec.SchemaRegistry.AllowSubjectDeletion,
+			},
+		},
+	}
+
+	if err := cm.checkKafkaSASLKerberos(); err != nil {
+		return "", err
+	}
+
+	consoleConfig.Kafka.RequestTimeoutOverrides, err = cm.genKafkaRequestTimeoutOverrides()
+	if err != nil {
+		return "", err
+	}
+
+	consoleConfig.Kafka.SessionTimeout, consoleConfig.Kafka.HeartbeatInterval, err = cm.genKafkaGroupTimeouts()
+	if err != nil {
+		return "", err
+	}
+
+	consoleConfig.Kafka.TLS, err = cm.genKafkaTLS()
+	if err != nil {
+		return "", err
+	}
+
+	if sr := cm.consoleobj.Spec.SchemaRegistry; sr.Enabled {
+		if timeout := sr.RequestTimeout; timeout != nil {
+			if timeout.Duration <= 0 {
+				return "", fmt.Errorf("request timeout of Schema Registry must be positive, got %s", timeout.Duration) //nolint:goerr113 // no need to declare new error type
+			}
+			consoleConfig.Kafka.Schema.RequestTimeout = timeout.Duration
+		}
+		consoleConfig.Kafka.Schema.PaginationSize = sr.PaginationSize
+	}
+
+	consoleConfig.Kafka.Schema.Username, consoleConfig.Kafka.Schema.Password, err = cm.genSchemaRegistryBasicAuth(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	if interval := cm.consoleobj.Spec.Console.StatsRefreshInterval; interval != nil {
+		if interval.Duration <= 0 {
+			return "", fmt.Errorf("stats refresh interval must be positive, got %s", interval.Duration) //nolint:goerr113 // no need to declare new error type
+		}
+		consoleConfig.Console.StatsRefreshInterval = interval.Duration
+	}
+
+	consoleConfig.Kafka.Proxy, err = cm.genKafkaProxy(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	consoleConfig.Server.MetricsAuth, err = cm.genMetricsAuth(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	consoleConfig.Server.AccessLog, err = cm.genServerAccessLog()
+	if err != nil {
+		return "", err
 	}
 
 	consoleConfig.Connect, err = cm.genConnect(ctx)
@@ -146,6 +317,10 @@ func (cm *ConfigMap) generateConsoleConfig(
 		return "", err
 	}
 
+	if cm.consoleobj.Spec.ConfigTemplateRef != nil {
+		return cm.renderConfigTemplate(ctx, consoleConfig)
+	}
+
 	config, err := yaml.Marshal(consoleConfig)
 	if err != nil {
 		return "", err
@@ -155,12 +330,28 @@ func (cm *ConfigMap) generateConsoleConfig(
 
 func (cm *ConfigMap) genEnterprise() (e Enterprise) {
 	if enterprise := cm.consoleobj.Spec.Enterprise; enterprise != nil {
-		return Enterprise{
+		var bindings []EnterpriseRBACDomainBinding
+		for _, b := range enterprise.RBAC.EmailDomainBindings {
+			bindings = append(bindings, EnterpriseRBACDomainBinding{
+				Domain:   strings.TrimPrefix(b.Domain, "@"),
+				RoleName: b.RoleName,
+			})
+		}
+		e = Enterprise{
 			RBAC: EnterpriseRBAC{
-				Enabled:              cm.consoleobj.Spec.Enterprise.RBAC.Enabled,
-				RoleBindingsFilepath: fmt.Sprintf("%s/%s", enterpriseRBACMountPath, EnterpriseRBACDataKey),
+				Enabled:                 cm.consoleobj.Spec.Enterprise.RBAC.Enabled,
+				RoleBindingsFilepath:    fmt.Sprintf("%s/%s", enterpriseRBACMountPath, EnterpriseRBACDataKey),
+				EmailDomainBindings:     bindings,
+				AllowedRoles:            enterprise.RBAC.AllowedRoles,
+				CaseInsensitiveSubjects: enterprise.RBAC.CaseInsensitiveSubjects,
 			},
 		}
+		if audit := enterprise.AuditLog; audit != nil {
+			e.Audit = &EnterpriseAuditLog{
+				Enabled:            audit.Enabled,
+				IncludeRequestBody: audit.IncludeRequestBody,
+			}
+		}
 	}
 	return e
 }
@@ -185,12 +376,28 @@ var (
 
 	// EnterpriseGoogleClientSecretKey is the required key in EnterpriseLoginGoogle Client secret
 	EnterpriseGoogleClientSecretKey = "clientSecret"
+
+	// EnterpriseOIDCClientIDSecretKey is the required key in EnterpriseLoginOIDC Client ID
+	EnterpriseOIDCClientIDSecretKey = "clientId"
+
+	// EnterpriseOIDCClientSecretKey is the required key in EnterpriseLoginOIDC Client secret
+	EnterpriseOIDCClientSecretKey = "clientSecret"
+
+	// EnterpriseGitHubClientIDSecretKey is the required key in EnterpriseLoginGitHub Client ID
+	EnterpriseGitHubClientIDSecretKey = "clientId"
+
+	// EnterpriseGitHubClientSecretKey is the required key in EnterpriseLoginGitHub Client secret
+	EnterpriseGitHubClientSecretKey = "clientSecret"
+
+	// EnterpriseGitHubOrganizationsDataKey is the required key in EnterpriseLoginGitHub organizations ConfigMap
+	EnterpriseGitHubOrganizationsDataKey = "organizations"
 )
 
 func (cm *ConfigMap) genLogin(ctx context.Context) (e EnterpriseLogin, err error) {
 	if provider := cm.consoleobj.Spec.Login; provider != nil { //nolint:nestif // login config is complex
 		enterpriseLogin := EnterpriseLogin{
-			Enabled: provider.Enabled,
+			Enabled:      provider.Enabled,
+			CallbackPath: provider.CallbackPath,
 		}
 
 		jwtSecret, err := provider.JWTSecretRef.GetSecret(ctx, cm.Client)
@@ -203,15 +410,42 @@ func (cm *ConfigMap) genLogin(ctx context.Context) (e EnterpriseLogin, err error
 		}
 		enterpriseLogin.JWTSecret = string(jwt)
 
-		switch {
-		case provider.RedpandaCloud != nil:
+		if rotation := provider.JWTRotation; rotation != nil && rotation.AllowPreviousSecretRef != nil {
+			previousSecret, err := rotation.AllowPreviousSecretRef.GetSecret(ctx, cm.Client)
+			if err != nil {
+				return e, err
+			}
+			previous, err := rotation.AllowPreviousSecretRef.GetValue(previousSecret, DefaultJWTSecretKey)
+			if err != nil {
+				return e, err
+			}
+			enterpriseLogin.JWT = &EnterpriseLoginJWT{
+				Rotation: EnterpriseLoginJWTRotation{PreviousSecret: string(previous)},
+			}
+		}
+
+		enterpriseLogin.Session, err = genLoginSession(provider.Session)
+		if err != nil {
+			return e, err
+		}
+
+		if provider.RedpandaCloud != nil {
 			enterpriseLogin.RedpandaCloud = &redpandav1alpha1.EnterpriseLoginRedpandaCloud{
 				Enabled:        provider.RedpandaCloud.Enabled,
 				Domain:         provider.RedpandaCloud.Domain,
 				Audience:       provider.RedpandaCloud.Audience,
 				AllowedOrigins: provider.RedpandaCloud.AllowedOrigins,
+
+				AdditionalScopes: provider.RedpandaCloud.AdditionalScopes,
+				RequireMFAClaim:  provider.RedpandaCloud.RequireMFAClaim,
+				UsePKCE:          provider.RedpandaCloud.UsePKCE,
+				ClaimMappings:    provider.RedpandaCloud.ClaimMappings,
 			}
-		case provider.Google != nil:
+			// RedpandaCloud is the only provider on the login page
+			return enterpriseLogin, nil
+		}
+		// Google and OIDC are both offered on the login page if enabled
+		if cm.consoleobj.IsGoogleLoginEnabled() {
 			cc := redpandav1alpha1.SecretKeyRef{
 				Namespace: provider.Google.ClientCredentialsRef.Namespace,
 				Name:      provider.Google.ClientCredentialsRef.Name,
@@ -220,6 +454,9 @@ func (cm *ConfigMap) genLogin(ctx context.Context) (e EnterpriseLogin, err error
 			if err != nil {
 				return e, err
 			}
+			if err := cm.checkLoginCredentialKeys(ccSecret, EnterpriseGoogleClientIDSecretKey, EnterpriseGoogleClientSecretKey); err != nil {
+				return e, err
+			}
 			clientID, err := cc.GetValue(ccSecret, EnterpriseGoogleClientIDSecretKey)
 			if err != nil {
 				return e, err
@@ -229,16 +466,42 @@ func (cm *ConfigMap) genLogin(ctx context.Context) (e EnterpriseLogin, err error
 				return e, err
 			}
 
+			cm.checkGoogleClientID(string(clientID))
+
 			enterpriseLogin.Google = &EnterpriseLoginGoogle{
 				Enabled:      provider.Google.Enabled,
 				ClientID:     string(clientID),
 				ClientSecret: string(clientSecret),
+
+				AdditionalScopes: provider.Google.AdditionalScopes,
+				RequireMFAClaim:  provider.Google.RequireMFAClaim,
+				UsePKCE:          provider.Google.UsePKCE,
+				ClaimMappings:    provider.Google.ClaimMappings,
 			}
 			if dir := provider.Google.Directory; dir != nil {
 				enterpriseLogin.Google.Directory = &EnterpriseLoginGoogleDirectory{
 					ServiceAccountFilepath: fmt.Sprintf("%s/%s", enterpriseGoogleSAMountPath, EnterpriseGoogleSADataKey),
 					TargetPrincipal:        provider.Google.Directory.TargetPrincipal,
 				}
+				if dir.RefreshInterval != nil {
+					if dir.RefreshInterval.Duration <= 0 {
+						return e, fmt.Errorf("google directory refresh interval must be positive, got %s", dir.RefreshInterval.Duration) //nolint:goerr113 // no need to declare new error type
+					}
+					enterpriseLogin.Google.Directory.RefreshInterval = dir.RefreshInterval.Duration
+				}
+			}
+		}
+		if cm.consoleobj.IsOIDCLoginEnabled() {
+			enterpriseLogin.OIDC, err = cm.genLoginOIDC(ctx, provider.OIDC)
+			if err != nil {
+				return e, err
+			}
+		}
+		// GitHub is offered along with Google or OIDC on the login page
+		if cm.consoleobj.IsGitHubLoginEnabled() {
+			enterpriseLogin.GitHub, err = cm.genLoginGitHub(ctx, provider.GitHub)
+			if err != nil {
+				return e, err
 			}
 		}
 		return enterpriseLogin, nil
@@ -246,6 +509,161 @@ func (cm *ConfigMap) genLogin(ctx context.Context) (e EnterpriseLogin, err error
 	return e, nil
 }
 
+// genLoginOIDC returns the generic OIDC provider config with credentials from the referenced Secret
+func (cm *ConfigMap) genLoginOIDC(
+	ctx context.Context, oidc *redpandav1alpha1.EnterpriseLoginOIDC,
+) (*EnterpriseLoginOIDC, error) {
+	cc := redpandav1alpha1.SecretKeyRef{
+		Namespace: oidc.ClientCredentialsRef.Namespace,
+		Name:      oidc.ClientCredentialsRef.Name,
+	}
+	ccSecret, err := cc.GetSecret(ctx, cm.Client)
+	if err != nil {
+		return nil, err
+	}
+	if err := cm.checkLoginCredentialKeys(ccSecret, EnterpriseOIDCClientIDSecretKey, EnterpriseOIDCClientSecretKey); err != nil {
+		return nil, err
+	}
+	clientID, err := cc.GetValue(ccSecret, EnterpriseOIDCClientIDSecretKey)
+	if err != nil {
+		return nil, err
+	}
+	clientSecret, err := cc.GetValue(ccSecret, EnterpriseOIDCClientSecretKey)
+	if err != nil {
+		return nil, err
+	}
+	return &EnterpriseLoginOIDC{
+		Enabled:      oidc.Enabled,
+		Issuer:       oidc.Issuer,
+		ClientID:     string(clientID),
+		ClientSecret: string(clientSecret),
+		Scopes:       oidc.Scopes,
+		DisplayName:  oidc.DisplayName,
+	}, nil
+}
+
+// googleClientIDPattern matches the OAuth client IDs issued by Google, e.g. "123456789012-abc123.apps.googleusercontent.com"
+var googleClientIDPattern = regexp.MustCompile(`^[0-9a-z-]+\.apps\.googleusercontent\.com$`)
+
+// checkGoogleClientID sets the LoginClientIDInvalid condition if the Google client ID is malformed
+// The config is still generated, Console fails the login with the malformed client ID
+func (cm *ConfigMap) checkGoogleClientID(clientID string) {
+	if googleClientIDPattern.MatchString(clientID) {
+		cm.consoleobj.Status.SetCondition(
+			redpandav1alpha1.LoginClientIDInvalidConditionType, corev1.ConditionFalse,
+			redpandav1alpha1.LoginClientIDInvalidReasonValid, "",
+		)
+		return
+	}
+	msg := fmt.Sprintf("Google client ID %q does not match %s", clientID, googleClientIDPattern)
+	cm.log.Info(msg)
+	cm.consoleobj.Status.SetCondition(
+		redpandav1alpha1.LoginClientIDInvalidConditionType, corev1.ConditionTrue,
+		redpandav1alpha1.LoginClientIDInvalidReasonMalformed, msg,
+	)
+}
+
+// genLoginGitHub returns the GitHub OAuth provider config with credentials from the referenced Secret
+// and the allowed organizations from the referenced ConfigMap
+func (cm *ConfigMap) genLoginGitHub(
+	ctx context.Context, github *redpandav1alpha1.EnterpriseLoginGitHub,
+) (*EnterpriseLoginGitHub, error) {
+	cc := redpandav1alpha1.SecretKeyRef{
+		Namespace: github.ClientCredentialsRef.Namespace,
+		Name:      github.ClientCredentialsRef.Name,
+	}
+	ccSecret, err := cc.GetSecret(ctx, cm.Client)
+	if err != nil {
+		return nil, err
+	}
+	if err := cm.checkLoginCredentialKeys(ccSecret, EnterpriseGitHubClientIDSecretKey, EnterpriseGitHubClientSecretKey); err != nil {
+		return nil, err
+	}
+	clientID, err := cc.GetValue(ccSecret, EnterpriseGitHubClientIDSecretKey)
+	if err != nil {
+		return nil, err
+	}
+	clientSecret, err := cc.GetValue(ccSecret, EnterpriseGitHubClientSecretKey)
+	if err != nil {
+		return nil, err
+	}
+	organizations, err := cm.genLoginGitHubOrganizations(ctx, github.OrganizationsRef)
+	if err != nil {
+		return nil, err
+	}
+	return &EnterpriseLoginGitHub{
+		Enabled:       github.Enabled,
+		ClientID:      string(clientID),
+		ClientSecret:  string(clientSecret),
+		Organizations: organizations,
+		Teams:         github.Teams,
+	}, nil
+}
+
+// genLoginGitHubOrganizations returns the organizations listed in the referenced ConfigMap, one per line
+// If the ConfigMap is not found, a LoginProviderDegraded ConditionError is returned and the config is not generated,
+// so that GitHub login is never rendered without the organization restriction
+func (cm *ConfigMap) genLoginGitHubOrganizations(
+	ctx context.Context, ref *corev1.LocalObjectReference,
+) ([]string, error) {
+	if ref == nil {
+		cm.consoleobj.Status.SetCondition(
+			redpandav1alpha1.LoginProviderDegradedConditionType, corev1.ConditionFalse,
+			redpandav1alpha1.LoginProviderDegradedReasonAvailable, "",
+		)
+		return nil, nil
+	}
+
+	key := types.NamespacedName{Namespace: cm.consoleobj.GetNamespace(), Name: ref.Name}
+	orgs := corev1.ConfigMap{}
+	if err := cm.Get(ctx, key, &orgs); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("getting GitHub organizations ConfigMap %s: %w", key, err)
+		}
+		return nil, &ConditionError{
+			Type:    redpandav1alpha1.LoginProviderDegradedConditionType,
+			Reason:  redpandav1alpha1.LoginProviderDegradedReasonOrganizationsNotFound,
+			Message: fmt.Sprintf("GitHub organizations ConfigMap %s not found", key),
+		}
+	}
+	cm.consoleobj.Status.SetCondition(
+		redpandav1alpha1.LoginProviderDegradedConditionType, corev1.ConditionFalse,
+		redpandav1alpha1.LoginProviderDegradedReasonAvailable, "",
+	)
+
+	var organizations []string
+	for _, line := range strings.Split(orgs.Data[EnterpriseGitHubOrganizationsDataKey], "\n") {
+		if org := strings.TrimSpace(line); org != "" {
+			organizations = append(organizations, org)
+		}
+	}
+	return organizations, nil
+}
+
+// checkLoginCredentialKeys returns a LoginCredentialKeyMissing ConditionError naming the keys missing in the login credentials Secret
+// The config cannot be generated, the controller sets the condition in the Console status
+func (cm *ConfigMap) checkLoginCredentialKeys(secret *corev1.Secret, keys ...string) error {
+	var missing []string
+	for _, key := range keys {
+		if _, ok := secret.Data[key]; !ok {
+			missing = append(missing, key)
+		}
+	}
+	if len(missing) == 0 {
+		cm.consoleobj.Status.SetCondition(
+			redpandav1alpha1.LoginCredentialKeyMissingConditionType, corev1.ConditionFalse,
+			redpandav1alpha1.LoginCredentialKeyMissingReasonPresent, "",
+		)
+		return nil
+	}
+
+	return &ConditionError{
+		Type:    redpandav1alpha1.LoginCredentialKeyMissingConditionType,
+		Reason:  redpandav1alpha1.LoginCredentialKeyMissingReasonMissing,
+		Message: fmt.Sprintf("Login credentials Secret %s/%s is missing keys: %s", secret.GetNamespace(), secret.GetName(), strings.Join(missing, ", ")),
+	}
+}
+
 func (cm *ConfigMap) genLicense(ctx context.Context) (string, error) {
 	if license := cm.consoleobj.Spec.LicenseRef; license != nil {
 		licenseSecret, err := license.GetSecret(ctx, cm.Client)
@@ -256,14 +674,50 @@ func (cm *ConfigMap) genLicense(ctx context.Context) (string, error) {
 		if err != nil {
 			return "", err
 		}
-		return string(licenseValue), nil
+		return decodeLicense(licenseValue, license.Encoding)
 	}
 	return "", nil
 }
 
-func (cm *ConfigMap) genServer() rest.Config {
+// decodeLicense decodes the license value according to the encoding
+// In auto mode, the value is decoded if it is base64 encoded text, otherwise it is used as is
+func decodeLicense(
+	value []byte, encoding redpandav1alpha1.LicenseEncoding,
+) (string, error) {
+	switch encoding {
+	case redpandav1alpha1.LicenseEncodingRaw:
+		return string(value), nil
+	case redpandav1alpha1.LicenseEncodingBase64:
+		decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(value)))
+		if err != nil {
+			return "", fmt.Errorf("decoding base64 license: %w", err)
+		}
+		return strings.TrimSpace(string(decoded)), nil
+	}
+	// Raw licenses contain "." which is not in the base64 alphabet
+	decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(value)))
+	if err != nil || !isText(decoded) {
+		return string(value), nil
+	}
+	return strings.TrimSpace(string(decoded)), nil
+}
+
+// isText returns true if b is non-empty UTF-8 text without control characters other than whitespace
+func isText(b []byte) bool {
+	if len(b) == 0 || !utf8.Valid(b) {
+		return false
+	}
+	for _, r := range string(b) {
+		if !unicode.IsPrint(r) && !unicode.IsSpace(r) {
+			return false
+		}
+	}
+	return true
+}
+
+func (cm *ConfigMap) genServer() Server {
 	server := cm.consoleobj.Spec.Server
-	return rest.Config{
+	c := rest.Config{
 		ServerGracefulShutdownTimeout:   server.ServerGracefulShutdownTimeout.Duration,
 		HTTPListenAddress:               server.HTTPListenAddress,
 		HTTPListenPort:                  server.HTTPListenPort,
@@ -275,6 +729,87 @@ func (cm *ConfigMap) genServer() rest.Config {
 		SetBasePathFromXForwardedPrefix: server.SetBasePathFromXForwardedPrefix,
 		StripPrefix:                     server.StripPrefix,
 	}
+	ui := ServerUI{}
+	if server.UI.RefreshInterval != nil {
+		ui.RefreshInterval = server.UI.RefreshInterval.Duration
+	}
+	// Console serves metrics at DefaultMetricsPath, only a custom path is rendered
+	var metricsPath string
+	if cm.consoleobj.Spec.Deployment.Metrics != nil && getMetricsPath(cm.consoleobj) != DefaultMetricsPath {
+		metricsPath = getMetricsPath(cm.consoleobj)
+	}
+	return Server{
+		Config:             c,
+		APIBasePath:        server.APIBasePath,
+		MaintenanceMode:    server.MaintenanceMode,
+		MaintenanceMessage: server.MaintenanceMessage,
+		UI:                 ui,
+		TLS:                cm.genServerTLS(),
+		TrustedProxyHops:   server.TrustedProxyHops,
+		RequestIDHeader:    server.RequestIDHeader,
+		RequestID:          cm.genServerRequestID(),
+		MetricsPath:        metricsPath,
+	}
+}
+
+// genServerAccessLog returns the access log config, all requests are logged if the sample rate is not set
+func (cm *ConfigMap) genServerAccessLog() (*ServerAccessLog, error) {
+	accessLog := cm.consoleobj.Spec.Server.AccessLog
+	if accessLog == nil {
+		return nil, nil
+	}
+	if accessLog.SampleRate == "" {
+		return &ServerAccessLog{SampleRate: 1}, nil
+	}
+	rate, err := strconv.ParseFloat(accessLog.SampleRate, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parsing access log sample rate: %w", err)
+	}
+	if rate < 0 || rate > 1 {
+		return nil, fmt.Errorf("access log sample rate %s must be between 0 and 1", accessLog.SampleRate) //nolint:goerr113 // no need to declare new error type
+	}
+	return &ServerAccessLog{SampleRate: rate}, nil
+}
+
+// genServerRequestID returns the request ID config, the header defaults to Server.RequestIDHeader
+func (cm *ConfigMap) genServerRequestID() *ServerRequestID {
+	server := cm.consoleobj.Spec.Server
+	if server.RequestID == nil {
+		return nil
+	}
+	header := server.RequestID.HeaderName
+	if header == "" {
+		header = server.RequestIDHeader
+	}
+	return &ServerRequestID{
+		Enabled:    server.RequestID.Enabled,
+		HeaderName: header,
+	}
+}
+
+func (cm *ConfigMap) genServerTLS() *ServerTLS {
+	if !cm.consoleobj.IsServerTLSEnabled() {
+		return nil
+	}
+	tls := &ServerTLS{
+		Enabled:      true,
+		CertFilepath: ServerTLSCertFilePath,
+		KeyFilepath:  ServerTLSKeyFilePath,
+	}
+	if reload := cm.consoleobj.Spec.Server.TLS.ReloadInterval; reload != nil {
+		tls.ReloadInterval = reload.Duration
+	}
+	if cm.consoleobj.IsServerTLSClientAuthEnabled() {
+		mode := cm.consoleobj.Spec.Server.TLS.ClientAuth.Mode
+		if mode == "" {
+			mode = redpandav1alpha1.ServerTLSClientAuthRequireAndVerify
+		}
+		tls.ClientAuth = &ServerTLSClientAuth{
+			Mode:       string(mode),
+			CaFilepath: ServerTLSClientCAFilePath,
+		}
+	}
+	return tls
 }
 
 var (
@@ -298,8 +833,86 @@ var (
 	ConnectTLSCaFilePath   = fmt.Sprintf("%s/%%s/%s", ConnectTLSDir, "ca.crt")
 	ConnectTLSCertFilePath = fmt.Sprintf("%s/%%s/%s", ConnectTLSDir, "tls.crt")
 	ConnectTLSKeyFilePath  = fmt.Sprintf("%s/%%s/%s", ConnectTLSDir, "tls.key")
+
+	ServerTLSDir              = "/etc/console/tls/server"
+	ServerTLSCertFilePath     = fmt.Sprintf("%s/%s", ServerTLSDir, "tls.crt")
+	ServerTLSKeyFilePath      = fmt.Sprintf("%s/%s", ServerTLSDir, "tls.key")
+	ServerTLSClientCADir      = "/etc/console/tls/client-ca"
+	ServerTLSClientCAFilePath = fmt.Sprintf("%s/%s", ServerTLSClientCADir, "ca.crt")
+
+	KafkaSASLOAuthTLSDir        = "/etc/console/tls/oauth-ca"
+	KafkaSASLOAuthTLSCAFilePath = fmt.Sprintf("%s/%s", KafkaSASLOAuthTLSDir, "ca.crt")
+
+	KafkaTLSDir        = "/etc/console/tls/kafka-ca"
+	KafkaTLSCAFilePath = fmt.Sprintf("%s/%s", KafkaTLSDir, "ca.crt")
+
+	KafkaSASLKerberosConfigKey  = "krb5.conf"
+	KafkaSASLKerberosConfigPath = "/etc/krb5.conf"
+
+	KafkaSASLKerberosKeytabKey  = "krb5.keytab"
+	KafkaSASLKerberosKeytabDir  = "/etc/console/kerberos"
+	KafkaSASLKerberosKeytabPath = fmt.Sprintf("%s/%s", KafkaSASLKerberosKeytabDir, KafkaSASLKerberosKeytabKey)
 )
 
+// KafkaClusterCAKey returns the Secret that contains the CA of the Kafka API of the Cluster
+// It is the node certificate Secret referenced by the TLS listener or generated by the operator
+// Returns false if no Kafka listener has TLS enabled
+func KafkaClusterCAKey(cluster *redpandav1alpha1.Cluster) (types.NamespacedName, bool) {
+	listeners := cluster.KafkaTLSListeners()
+	if len(listeners) == 0 {
+		return types.NamespacedName{}, false
+	}
+	// All TLS listeners share the same certificates
+	if ref := listeners[0].TLS.NodeSecretRef; ref != nil && ref.Name != "" {
+		// Secrets in other namespaces are copied to the Cluster namespace by the operator
+		return types.NamespacedName{Namespace: cluster.GetNamespace(), Name: ref.Name}, true
+	}
+	name := certmanager.NewCertName(cluster.GetName(), certmanager.RedpandaNodeCert)
+	return types.NamespacedName{Namespace: cluster.GetNamespace(), Name: string(name)}, true
+}
+
+// kafkaTLSCAKey returns the Secret that contains the CA of the brokers, false if the system CAs are used
+func kafkaTLSCAKey(
+	consoleobj *redpandav1alpha1.Console, cluster *redpandav1alpha1.Cluster,
+) (types.NamespacedName, bool) {
+	tls := consoleobj.Spec.Kafka.TLS
+	switch {
+	case tls == nil:
+		return types.NamespacedName{}, false
+	case tls.UseClusterCA:
+		return KafkaClusterCAKey(cluster)
+	case tls.CARef != nil:
+		return types.NamespacedName{Namespace: consoleobj.GetNamespace(), Name: tls.CARef.Name}, true
+	}
+	return types.NamespacedName{}, false
+}
+
+// genKafkaTLS returns the TLS config of the Kafka client
+// The Cluster CA is mounted as volume, so Console must be in the Cluster namespace
+func (cm *ConfigMap) genKafkaTLS() (KafkaTLS, error) {
+	tls := cm.consoleobj.Spec.Kafka.TLS
+	if tls == nil {
+		return KafkaTLS{TLSConfig: kafka.TLSConfig{Enabled: false}}, nil
+	}
+	if tls.UseClusterCA {
+		key, ok := KafkaClusterCAKey(cm.clusterobj)
+		if !ok {
+			return KafkaTLS{}, fmt.Errorf("cluster %s/%s has no Kafka listener with TLS enabled", cm.clusterobj.GetNamespace(), cm.clusterobj.GetName()) //nolint:goerr113 // no need to declare new error type
+		}
+		if key.Namespace != cm.consoleobj.GetNamespace() {
+			return KafkaTLS{}, fmt.Errorf("cluster CA can only be used if Console is in the Cluster namespace %s", key.Namespace) //nolint:goerr113 // no need to declare new error type
+		}
+	}
+	c := KafkaTLS{
+		TLSConfig:     kafka.TLSConfig{Enabled: true},
+		Renegotiation: string(tls.Renegotiation),
+	}
+	if _, ok := kafkaTLSCAKey(cm.consoleobj, cm.clusterobj); ok {
+		c.CaFilepath = KafkaTLSCAFilePath
+	}
+	return c, nil
+}
+
 // SchemaRegistryTLSCa handles mounting CA cert
 type SchemaRegistryTLSCa struct {
 	NodeSecretRef *corev1.ObjectReference
@@ -334,10 +947,15 @@ func (s *SchemaRegistryTLSCa) useCaCert() bool {
 	return !UsePublicCerts && s.NodeSecretRef != nil
 }
 
-func (cm *ConfigMap) genKafka(username, password string) kafka.Config {
-	k := kafka.Config{
-		Brokers:  getBrokers(cm.clusterobj),
-		ClientID: fmt.Sprintf("redpanda-console-%s-%s", cm.consoleobj.GetNamespace(), cm.consoleobj.GetName()),
+func (cm *ConfigMap) genKafka(credentials *corev1.Secret) Kafka {
+	brokers := getBrokers(cm.clusterobj)
+	if cm.consoleobj.Spec.Kafka.SRVRecord != "" {
+		brokers = cm.consoleobj.Status.ResolvedBrokers
+	}
+	k := Kafka{
+		Brokers:      brokers,
+		ClientID:     fmt.Sprintf("redpanda-console-%s-%s", cm.consoleobj.GetNamespace(), cm.consoleobj.GetName()),
+		HTTPProxyURL: cm.consoleobj.Spec.Kafka.HTTPProxyURL,
 	}
 
 	schemaRegistry := schema.Config{Enabled: false}
@@ -359,14 +977,47 @@ func (cm *ConfigMap) genKafka(username, password string) kafka.Config {
 		}
 		schemaRegistry = schema.Config{Enabled: y, URLs: []string{cm.clusterobj.SchemaRegistryAPIURL()}, TLS: tls}
 	}
-	k.Schema = schemaRegistry
+	k.Schema = SchemaRegistry{Config: schemaRegistry}
 
-	sasl := kafka.SASLConfig{Enabled: false}
+	username := string(credentials.Data[corev1.BasicAuthUsernameKey])
+	password := string(credentials.Data[corev1.BasicAuthPasswordKey])
+	sasl := KafkaSASL{Enabled: false}
 	// Set defaults because Console complains SASL mechanism is not set even if SASL is disabled
 	sasl.SetDefaults()
-	if yes := cm.clusterobj.Spec.EnableSASL; yes {
-		sasl = kafka.SASLConfig{
-			Enabled:   yes,
+	switch {
+	case cm.consoleobj.IsExternalSASLEnabled():
+		external := cm.consoleobj.Spec.Kafka.SASL
+		sasl = KafkaSASL{
+			Enabled:   true,
+			Username:  string(credentials.Data[external.GetUsernameKey()]),
+			Password:  string(credentials.Data[external.GetPasswordKey()]),
+			Mechanism: string(external.Mechanism),
+		}
+		if external.Mechanism == redpandav1alpha1.KafkaSASLMechanismOAuthBearer {
+			sasl = KafkaSASL{
+				Enabled:   true,
+				Mechanism: string(external.Mechanism),
+				OAUth:     genKafkaSASLOAuth(external.OAuth, credentials),
+			}
+		}
+		if external.Mechanism == redpandav1alpha1.KafkaSASLMechanismAWSMSKIAM {
+			sasl = KafkaSASL{
+				Enabled:   true,
+				Mechanism: string(external.Mechanism),
+				AWSMskIam: genKafkaSASLAWSMskIam(external.AWSMSKIAM, credentials),
+			}
+		}
+		if cm.consoleobj.IsKafkaSASLKerberosEnabled() {
+			sasl = KafkaSASL{
+				Enabled:      true,
+				Mechanism:    string(external.Mechanism),
+				GSSAPIConfig: cm.genKafkaSASLGSSAPI(credentials),
+			}
+		}
+		sasl.HandshakeVersion = external.HandshakeVersion
+	case cm.clusterobj.Spec.EnableSASL:
+		sasl = KafkaSASL{
+			Enabled:   true,
 			Username:  username,
 			Password:  password,
 			Mechanism: admin.ScramSha256,
@@ -374,9 +1025,294 @@ func (cm *ConfigMap) genKafka(username, password string) kafka.Config {
 	}
 	k.SASL = sasl
 
+	if p := cm.consoleobj.Spec.Kafka.Producer; p != nil && p.Acks != "" {
+		k.Producer = &KafkaProducer{Acks: string(p.Acks)}
+	}
+
+	if c := cm.consoleobj.Spec.Kafka.Consumer; c != nil {
+		k.EnableRackAwareConsumer = c.RackAware
+		k.MaxConcurrentFetches = c.MaxConcurrentFetches
+	}
+
+	k.MaxPollRecords = cm.consoleobj.Spec.Kafka.MaxPollRecords
+	for _, codec := range cm.consoleobj.Spec.Kafka.SupportedCompressionCodecs {
+		k.SupportedCompressionCodecs = append(k.SupportedCompressionCodecs, string(codec))
+	}
+	if idle := cm.consoleobj.Spec.Kafka.ConnectionMaxIdle; idle != nil {
+		k.ConnectionMaxIdle = idle.Duration
+	}
+	if timeout := cm.consoleobj.Spec.Kafka.BrokerTimeout; timeout != nil {
+		k.BrokerTimeout = timeout.Duration
+	}
+	k.Retries = cm.consoleobj.Spec.Kafka.Retries
+	if backoff := cm.consoleobj.Spec.Kafka.RetryBackoff; backoff != nil {
+		k.RetryBackoff = backoff.Duration
+	}
+	k.Protobuf = cm.genProtobuf()
+
 	return k
 }
 
+// genProtobuf returns the Protobuf config that reads proto files from the mounted ConfigMap
+func (cm *ConfigMap) genProtobuf() proto.Config {
+	protobuf := cm.consoleobj.Spec.Kafka.Protobuf
+	if protobuf == nil {
+		return proto.Config{Enabled: false}
+	}
+	p := proto.Config{Enabled: true}
+	p.SetDefaults()
+	p.FileSystem.Enabled = true
+	p.FileSystem.Paths = []string{protobufMountPath}
+	for _, m := range protobuf.Mappings {
+		p.Mappings = append(p.Mappings, proto.ConfigTopicMapping{
+			TopicName:      m.TopicName,
+			KeyProtoType:   m.KeyProtoType,
+			ValueProtoType: m.ValueProtoType,
+		})
+	}
+	return p
+}
+
+// genKafkaRequestTimeoutOverrides parses the request timeout per Kafka API
+func (cm *ConfigMap) genKafkaRequestTimeoutOverrides() (map[string]time.Duration, error) {
+	overrides := cm.consoleobj.Spec.Kafka.RequestTimeoutOverrides
+	if len(overrides) == 0 {
+		return nil, nil
+	}
+	timeouts := make(map[string]time.Duration, len(overrides))
+	for api, value := range overrides {
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return nil, fmt.Errorf("parsing request timeout override for Kafka API %s: %w", api, err)
+		}
+		if d <= 0 {
+			return nil, fmt.Errorf("request timeout override for Kafka API %s must be positive, got %s", api, value) //nolint:goerr113 // no need to declare new error type
+		}
+		timeouts[api] = d
+	}
+	return timeouts, nil
+}
+
+// genKafkaGroupTimeouts returns the consumer group session timeout and heartbeat interval
+// The heartbeat interval must be shorter than the session timeout, otherwise the member is removed from the group
+func (cm *ConfigMap) genKafkaGroupTimeouts() (session, heartbeat time.Duration, err error) {
+	if d := cm.consoleobj.Spec.Kafka.SessionTimeout; d != nil {
+		session = d.Duration
+	}
+	if d := cm.consoleobj.Spec.Kafka.HeartbeatInterval; d != nil {
+		heartbeat = d.Duration
+	}
+	if session < 0 || heartbeat < 0 {
+		return 0, 0, fmt.Errorf("consumer group session timeout %s and heartbeat interval %s must not be negative", session, heartbeat) //nolint:goerr113 // no need to declare new error type
+	}
+	if session > 0 && heartbeat > 0 && heartbeat >= session {
+		return 0, 0, fmt.Errorf("consumer group heartbeat interval %s must be shorter than session timeout %s", heartbeat, session) //nolint:goerr113 // no need to declare new error type
+	}
+	return session, heartbeat, nil
+}
+
+// genBranding returns the Console UI branding config
+func (cm *ConfigMap) genBranding() *ConsoleBranding {
+	branding := cm.consoleobj.Spec.Console.Branding
+	if branding == nil {
+		return nil
+	}
+	return &ConsoleBranding{EnvironmentLabel: branding.EnvironmentLabel}
+}
+
+// genSchemaRegistryBasicAuth returns the Schema Registry credentials from the referenced Secret
+// or the inline username with the referenced password, the Secrets may be in another namespace than Console
+func (cm *ConfigMap) genSchemaRegistryBasicAuth(ctx context.Context) (username, password string, err error) {
+	sr := cm.consoleobj.Spec.SchemaRegistry
+	if !sr.Enabled {
+		return "", "", nil
+	}
+	if sr.BasicAuthRef != nil {
+		secret := corev1.Secret{}
+		if err := cm.Get(ctx, SchemaRegistryBasicAuthKey(cm.consoleobj), &secret); err != nil {
+			return "", "", fmt.Errorf("getting Schema Registry basic auth Secret: %w", err)
+		}
+		// Don't stop reconciliation if key not found, fail in Console instead
+		return getOrEmpty(corev1.BasicAuthUsernameKey, secret.Data), getOrEmpty(corev1.BasicAuthPasswordKey, secret.Data), nil
+	}
+	if sr.PasswordRef != nil {
+		secret, err := sr.PasswordRef.GetSecret(ctx, cm.Client)
+		if err != nil {
+			return "", "", err
+		}
+		value, err := sr.PasswordRef.GetValue(secret, corev1.BasicAuthPasswordKey)
+		if err != nil {
+			return "", "", err
+		}
+		password = string(value)
+	}
+	return sr.Username, password, nil
+}
+
+// SchemaRegistryBasicAuthKey returns the key of the Secret that contains Schema Registry credentials
+func SchemaRegistryBasicAuthKey(consoleobj *redpandav1alpha1.Console) types.NamespacedName {
+	ref := consoleobj.Spec.SchemaRegistry.BasicAuthRef
+	namespace := ref.Namespace
+	if namespace == "" {
+		namespace = consoleobj.GetNamespace()
+	}
+	return types.NamespacedName{Namespace: namespace, Name: ref.Name}
+}
+
+// genLoginSession returns the session durations, the idle timeout must not be longer than the session duration
+func genLoginSession(session *redpandav1alpha1.EnterpriseLoginSession) (*EnterpriseLoginSession, error) {
+	if session == nil {
+		return nil, nil
+	}
+	s := &EnterpriseLoginSession{}
+	for _, d := range []struct {
+		name  string
+		value *metav1.Duration
+		out   *time.Duration
+	}{
+		{"duration", session.Duration, &s.Duration},
+		{"idle timeout", session.IdleTimeout, &s.IdleTimeout},
+	} {
+		if d.value == nil {
+			continue
+		}
+		if d.value.Duration <= 0 {
+			return nil, fmt.Errorf("login session %s must be positive, got %s", d.name, d.value.Duration) //nolint:goerr113 // no need to declare new error type
+		}
+		*d.out = d.value.Duration
+	}
+	if s.Duration > 0 && s.IdleTimeout > s.Duration {
+		return nil, fmt.Errorf("login session idle timeout %s must not be longer than duration %s", s.IdleTimeout, s.Duration) //nolint:goerr113 // no need to declare new error type
+	}
+	return s, nil
+}
+
+// genMetricsAuth returns the basic auth credentials of the metrics endpoint from the referenced Secret
+func (cm *ConfigMap) genMetricsAuth(ctx context.Context) (*ServerMetricsAuth, error) {
+	auth := cm.consoleobj.Spec.Server.MetricsAuth
+	if auth == nil {
+		return nil, nil
+	}
+	ref := auth.BasicAuthRef
+	secret := corev1.Secret{}
+	if err := cm.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, &secret); err != nil {
+		return nil, fmt.Errorf("getting metrics basic auth Secret %s/%s: %w", ref.Namespace, ref.Name, err)
+	}
+	return &ServerMetricsAuth{
+		Username: string(secret.Data[corev1.BasicAuthUsernameKey]),
+		Password: string(secret.Data[corev1.BasicAuthPasswordKey]),
+	}, nil
+}
+
+// genKafkaProxy returns the SOCKS5 proxy config with credentials from the referenced Secret
+func (cm *ConfigMap) genKafkaProxy(ctx context.Context) (*KafkaProxy, error) {
+	proxy := cm.consoleobj.Spec.Kafka.Proxy
+	if proxy == nil {
+		return nil, nil
+	}
+	if _, _, err := net.SplitHostPort(proxy.SOCKS5Address); err != nil {
+		return nil, fmt.Errorf("invalid SOCKS5 proxy address %q: %w", proxy.SOCKS5Address, err)
+	}
+
+	p := &KafkaProxy{SOCKS5Address: proxy.SOCKS5Address}
+	if ref := proxy.CredentialsRef; ref != nil {
+		secret := corev1.Secret{}
+		if err := cm.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, &secret); err != nil {
+			return nil, fmt.Errorf("getting SOCKS5 proxy credentials Secret %s/%s: %w", ref.Namespace, ref.Name, err)
+		}
+		p.Username = string(secret.Data[corev1.BasicAuthUsernameKey])
+		p.Password = string(secret.Data[corev1.BasicAuthPasswordKey])
+	}
+	return p, nil
+}
+
+// Optional keys in Kafka SASL credentials for AWS_MSK_IAM mechanism
+const (
+	KafkaSASLAWSAccessKeyKey    = "accessKey"
+	KafkaSASLAWSSecretKeyKey    = "secretKey"
+	KafkaSASLAWSSessionTokenKey = "sessionToken"
+)
+
+func genKafkaSASLAWSMskIam(
+	iam *redpandav1alpha1.KafkaSASLAWSMSKIAM, credentials *corev1.Secret,
+) KafkaSASLAWSMskIam {
+	i := KafkaSASLAWSMskIam{
+		SASLAwsMskIam: kafka.SASLAwsMskIam{
+			AccessKey:    string(credentials.Data[KafkaSASLAWSAccessKeyKey]),
+			SecretKey:    string(credentials.Data[KafkaSASLAWSSecretKeyKey]),
+			SessionToken: string(credentials.Data[KafkaSASLAWSSessionTokenKey]),
+		},
+	}
+	if iam != nil {
+		i.Region = iam.Region
+		i.RoleARN = iam.RoleARN
+	}
+	return i
+}
+
+// Kerberos authentication types of GSSAPI mechanism
+const (
+	kafkaSASLGSSAPIAuthTypeUser   = "USER_AUTH"
+	kafkaSASLGSSAPIAuthTypeKeytab = "KEYTAB_AUTH"
+)
+
+// genKafkaSASLGSSAPI returns the Kerberos config, Console authenticates with the keytab if set, otherwise with the password
+func (cm *ConfigMap) genKafkaSASLGSSAPI(credentials *corev1.Secret) kafka.SASLGSSAPIConfig {
+	external := cm.consoleobj.Spec.Kafka.SASL
+	g := kafka.SASLGSSAPIConfig{
+		AuthType:           kafkaSASLGSSAPIAuthTypeUser,
+		KerberosConfigPath: KafkaSASLKerberosConfigPath,
+		ServiceName:        external.Kerberos.ServiceName,
+		Username:           string(credentials.Data[external.GetUsernameKey()]),
+		Password:           string(credentials.Data[external.GetPasswordKey()]),
+		Realm:              external.Kerberos.Realm,
+	}
+	// Keep Console default
+	g.SetDefaults()
+	if cm.consoleobj.IsKafkaSASLKerberosKeytabEnabled() {
+		g.AuthType = kafkaSASLGSSAPIAuthTypeKeytab
+		g.KeyTabPath = KafkaSASLKerberosKeytabPath
+		g.Password = ""
+	}
+	return g
+}
+
+// checkKafkaSASLKerberos returns an error if Kerberos is not configured for GSSAPI mechanism or configured for another mechanism
+// Otherwise the mounted Kerberos config would never be read by Console
+func (cm *ConfigMap) checkKafkaSASLKerberos() error {
+	sasl := cm.consoleobj.Spec.Kafka.SASL
+	if sasl == nil {
+		return nil
+	}
+	isGSSAPI := sasl.Mechanism == redpandav1alpha1.KafkaSASLMechanismGSSAPI
+	switch {
+	case isGSSAPI && sasl.Kerberos == nil:
+		return fmt.Errorf("kafka SASL mechanism %s requires Kerberos config", sasl.Mechanism) //nolint:goerr113 // no need to declare new error type
+	case !isGSSAPI && sasl.Kerberos != nil:
+		return fmt.Errorf("kafka SASL Kerberos config is only allowed for mechanism %s, got %s", redpandav1alpha1.KafkaSASLMechanismGSSAPI, sasl.Mechanism) //nolint:goerr113 // no need to declare new error type
+	}
+	return nil
+}
+
+// KafkaSASLOAuthTokenKey is the required key in Kafka SASL credentials for OAUTHBEARER mechanism
+var KafkaSASLOAuthTokenKey = "token"
+
+func genKafkaSASLOAuth(
+	oauth *redpandav1alpha1.KafkaSASLOAuth, credentials *corev1.Secret,
+) KafkaSASLOAuth {
+	o := KafkaSASLOAuth{Token: string(credentials.Data[KafkaSASLOAuthTokenKey])}
+	if oauth != nil {
+		if oauth.RefreshBeforeExpiry != nil {
+			o.RefreshBeforeExpiry = oauth.RefreshBeforeExpiry.Duration
+		}
+		o.ReauthenticationEnabled = oauth.ReauthenticationEnabled
+		if oauth.TLS != nil {
+			o.TLS = &KafkaSASLOAuthTLS{CaFilepath: KafkaSASLOAuthTLSCAFilePath}
+		}
+	}
+	return o
+}
+
 func getBrokers(clusterobj *redpandav1alpha1.Cluster) []string {
 	if l := clusterobj.InternalListener(); l != nil {
 		brokers := []string{}
@@ -390,8 +1326,44 @@ func getBrokers(clusterobj *redpandav1alpha1.Cluster) []string {
 	return clusterobj.Status.Nodes.External
 }
 
-func (cm *ConfigMap) genConnect(ctx context.Context) (conn connect.Config, err error) {
-	clusters := []connect.ConfigCluster{}
+// SRVResolver resolves DNS SRV records, implemented by net.Resolver
+type SRVResolver interface {
+	LookupSRV(ctx context.Context, service, proto, name string) (string, []*net.SRV, error)
+}
+
+// ResolveSRVBrokers returns the broker addresses of the SRV record sorted by address
+// The resolver shuffles records of equal priority, sorting keeps the result stable between lookups
+// so that the config is regenerated only if brokers actually change
+func ResolveSRVBrokers(
+	ctx context.Context, resolver SRVResolver, record string,
+) ([]string, error) {
+	_, addrs, err := resolver.LookupSRV(ctx, "", "", record)
+	if err != nil {
+		return nil, fmt.Errorf("looking up SRV record %s: %w", record, err)
+	}
+	brokers := []string{}
+	for _, addr := range addrs {
+		host := strings.TrimSuffix(addr.Target, ".")
+		brokers = append(brokers, net.JoinHostPort(host, fmt.Sprintf("%d", addr.Port)))
+	}
+	sort.Strings(brokers)
+	return brokers, nil
+}
+
+// DefaultSRVRefreshInterval is the interval to resolve the SRV record again if not set
+// It matches the CRD default, which is not applied to Consoles stored before the field was added
+const DefaultSRVRefreshInterval = 5 * time.Minute
+
+// GetSRVRefreshInterval returns the interval to resolve the SRV record again, DefaultSRVRefreshInterval if not set
+func GetSRVRefreshInterval(consoleobj *redpandav1alpha1.Console) time.Duration {
+	if interval := consoleobj.Spec.Kafka.SRVRefreshInterval; interval != nil && interval.Duration > 0 {
+		return interval.Duration
+	}
+	return DefaultSRVRefreshInterval
+}
+
+func (cm *ConfigMap) genConnect(ctx context.Context) (conn Connect, err error) {
+	clusters := []ConnectCluster{}
 	for _, c := range cm.consoleobj.Spec.Connect.Clusters {
 		cluster, err := cm.buildConfigCluster(ctx, c)
 		if err != nil {
@@ -400,7 +1372,7 @@ func (cm *ConfigMap) genConnect(ctx context.Context) (conn connect.Config, err e
 		clusters = append(clusters, *cluster)
 	}
 
-	return connect.Config{
+	return Connect{
 		Enabled:        cm.consoleobj.Spec.Connect.Enabled,
 		Clusters:       clusters,
 		ConnectTimeout: cm.consoleobj.Spec.Connect.ConnectTimeout.Duration,
@@ -418,8 +1390,11 @@ func getOrEmpty(key string, data map[string][]byte) string {
 
 func (cm *ConfigMap) buildConfigCluster(
 	ctx context.Context, c redpandav1alpha1.ConnectCluster,
-) (*connect.ConfigCluster, error) {
-	cluster := &connect.ConfigCluster{Name: c.Name, URL: c.URL}
+) (*ConnectCluster, error) {
+	cluster := &ConnectCluster{
+		ConfigCluster:          connect.ConfigCluster{Name: c.Name, URL: c.URL},
+		HiddenConnectorClasses: c.HiddenConnectorClasses,
+	}
 
 	if c.BasicAuthRef != nil {
 		ref := corev1.Secret{}
@@ -454,33 +1429,67 @@ func (cm *ConfigMap) buildConfigCluster(
 }
 
 // DeleteUnused makes sure that old unreferenced ConfigMaps are deleted
-// ConfigMaps are recreated upon Console update, old ones should be cleaned up
+// This also deletes config Secrets, e.g. if Deployment.ConfigInSecret is toggled
 func (cm *ConfigMap) DeleteUnused(ctx context.Context) error {
 	if ref := cm.consoleobj.Status.ConfigMapRef; ref != nil {
-		if err := cm.delete(ctx, ref.Name); err != nil {
+		if err := cm.delete(ctx, ref); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func (cm *ConfigMap) delete(ctx context.Context, skip string) error {
-	cms := &corev1.ConfigMapList{}
-	if err := cm.List(ctx, cms, client.MatchingLabels(labels.ForConsole(cm.consoleobj)), client.InNamespace(cm.consoleobj.GetNamespace())); err != nil {
+func (cm *ConfigMap) delete(ctx context.Context, skip *corev1.ObjectReference) error {
+	objs, err := cm.listConfigObjects(ctx)
+	if err != nil {
 		return err
 	}
-	for _, obj := range cms.Items { //nolint:gocritic // more readable, configmap list is few
-		if skip != "" && skip == obj.GetName() {
+	for _, obj := range objs {
+		if skip != nil && skip.Name == obj.GetName() && getConfigKind(skip) == getObjectConfigKind(obj) {
 			continue
 		}
-		obj := obj
-		if err := cm.Delete(ctx, &obj); err != nil {
+		if err := cm.Delete(ctx, obj); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
+// listConfigObjects returns the ConfigMaps and Secrets that contain the Console config
+func (cm *ConfigMap) listConfigObjects(ctx context.Context) ([]client.Object, error) {
+	cms := &corev1.ConfigMapList{}
+	if err := cm.List(ctx, cms, client.MatchingLabels(labels.ForConsole(cm.consoleobj)), client.InNamespace(cm.consoleobj.GetNamespace())); err != nil {
+		return nil, err
+	}
+	secrets := &corev1.SecretList{}
+	if err := cm.List(ctx, secrets, client.MatchingLabels(cm.configSecretLabels()), client.InNamespace(cm.consoleobj.GetNamespace())); err != nil {
+		return nil, err
+	}
+	objs := make([]client.Object, 0, len(cms.Items)+len(secrets.Items))
+	for i := range cms.Items {
+		objs = append(objs, &cms.Items[i])
+	}
+	for i := range secrets.Items {
+		objs = append(objs, &secrets.Items[i])
+	}
+	return objs, nil
+}
+
+// getConfigKind returns the kind of the referenced config, references without kind are ConfigMaps
+func getConfigKind(ref *corev1.ObjectReference) string {
+	if ref.Kind == "" {
+		return configKindConfigMap
+	}
+	return ref.Kind
+}
+
+func getObjectConfigKind(obj client.Object) string {
+	if _, ok := obj.(*corev1.Secret); ok {
+		return configKindSecret
+	}
+	return configKindConfigMap
+}
+
 var (
 	// During reconciliation old ConfigMap might still be present so max expected is two
 	expectedConfigMapCount = 2
@@ -492,11 +1501,11 @@ var (
 // isConfigMapDeleted checks if attached ConfigMap is more than expected
 // This prevents the controller to create multiple ConfigMaps until old ones are garbage collected
 func (cm *ConfigMap) isConfigMapDeleted(ctx context.Context) error {
-	cms := &corev1.ConfigMapList{}
-	if err := cm.List(ctx, cms, client.MatchingLabels(labels.ForConsole(cm.consoleobj)), client.InNamespace(cm.consoleobj.GetNamespace())); err != nil {
+	objs, err := cm.listConfigObjects(ctx)
+	if err != nil {
 		return err
 	}
-	if len(cms.Items) > expectedConfigMapCount {
+	if len(objs) > expectedConfigMapCount {
 		return ErrMultipleConfigMap
 	}
 	return nil
@@ -0,0 +1,1368 @@
+// Copyright 2022 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package console_test
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cloudhut/common/rest"
+	"github.com/redpanda-data/console/backend/pkg/connect"
+	"github.com/redpanda-data/console/backend/pkg/kafka"
+	redpandav1alpha1 "github.com/redpanda-data/redpanda/src/go/k8s/apis/redpanda/v1alpha1"
+	"github.com/redpanda-data/redpanda/src/go/k8s/pkg/console"
+	"github.com/redpanda-data/redpanda/src/go/k8s/pkg/labels"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// upstreamConfig is the config loaded by the Console version the operator is built against
+// Console loads the config with unknown keys rejected, Enterprise fields are the ones of the Enterprise build
+type upstreamConfig struct {
+	MetricsNamespace string         `yaml:"metricsNamespace"`
+	ServeFrontend    bool           `yaml:"serveFrontend"`
+	Server           rest.Config    `yaml:"server"`
+	Kafka            kafka.Config   `yaml:"kafka"`
+	Connect          connect.Config `yaml:"connect"`
+
+	License    string `yaml:"license"`
+	Enterprise struct {
+		RBAC struct {
+			Enabled              bool   `yaml:"enabled"`
+			RoleBindingsFilepath string `yaml:"roleBindingsFilepath"`
+		} `yaml:"rbac"`
+	} `yaml:"enterprise"`
+	Login struct {
+		Enabled   bool   `yaml:"enabled"`
+		JWTSecret string `yaml:"jwtSecret"`
+		Google    *struct {
+			Enabled      bool   `yaml:"enabled"`
+			ClientID     string `yaml:"clientId"`
+			ClientSecret string `yaml:"clientSecret"`
+			Directory    *struct {
+				ServiceAccountFilepath string `yaml:"serviceAccountFilepath"`
+				TargetPrincipal        string `yaml:"targetPrincipal"`
+			} `yaml:"directory"`
+		} `yaml:"google"`
+		RedpandaCloud *struct {
+			Enabled        bool   `yaml:"enabled"`
+			Domain         string `yaml:"domain"`
+			Audience       string `yaml:"audience"`
+			AllowedOrigins string `yaml:"allowedOrigins"`
+		} `yaml:"redpandaCloud"`
+	} `yaml:"login"`
+}
+
+// decodeConfig checks that Console accepts the generated config and returns it
+func decodeConfig(t *testing.T, data []byte) *console.ConsoleConfig {
+	t.Helper()
+	require.NoError(t, yaml.UnmarshalStrict(data, &upstreamConfig{}))
+	cc := &console.ConsoleConfig{}
+	require.NoError(t, yaml.UnmarshalStrict(data, cc))
+	return cc
+}
+
+// ensureConfig runs the ConfigMap resource and returns the generated Console config
+func ensureConfig(
+	t *testing.T, c client.Client, consoleobj *redpandav1alpha1.Console, cluster *redpandav1alpha1.Cluster,
+) *console.ConsoleConfig {
+	t.Helper()
+	require.NoError(t, redpandav1alpha1.AddToScheme(scheme.Scheme))
+	ctx := context.Background()
+	createKafkaSASecret(t, c, consoleobj)
+
+	consoleobj.Status.ConfigMapRef = nil
+	cm := console.NewConfigMap(c, scheme.Scheme, consoleobj, cluster, ctrl.Log.WithName("test"))
+	require.NoError(t, cm.Ensure(ctx))
+	require.NotNil(t, consoleobj.Status.ConfigMapRef)
+
+	obj := &corev1.ConfigMap{}
+	require.NoError(t, c.Get(ctx, client.ObjectKey{Namespace: consoleobj.Status.ConfigMapRef.Namespace, Name: consoleobj.Status.ConfigMapRef.Name}, obj))
+	return decodeConfig(t, []byte(obj.Data["config.yaml"]))
+}
+
+// ensureConfigUnsupported runs the ConfigMap resource and checks that the config is rejected
+// because the fields are not supported by Console
+func ensureConfigUnsupported(
+	t *testing.T, c client.Client, consoleobj *redpandav1alpha1.Console, cluster *redpandav1alpha1.Cluster, fields ...string,
+) {
+	t.Helper()
+	require.NoError(t, redpandav1alpha1.AddToScheme(scheme.Scheme))
+	ctx := context.Background()
+	createKafkaSASecret(t, c, consoleobj)
+
+	consoleobj.Status.ConfigMapRef = nil
+	err := console.NewConfigMap(c, scheme.Scheme, consoleobj, cluster, ctrl.Log.WithName("test")).Ensure(ctx)
+	var ce *console.ConditionError
+	require.True(t, errors.As(err, &ce), "expected ConditionError, got %v", err)
+	assert.Equal(t, redpandav1alpha1.ConfigUnsupportedConditionType, ce.Type)
+	assert.Equal(t, redpandav1alpha1.ConfigUnsupportedReasonUnknownKeys, ce.Reason)
+	for _, field := range fields {
+		assert.Contains(t, ce.Message, fmt.Sprintf("field %s not found", field))
+	}
+	assert.Nil(t, consoleobj.Status.ConfigMapRef)
+}
+
+func createKafkaSASecret(t *testing.T, c client.Client, consoleobj *redpandav1alpha1.Console) {
+	t.Helper()
+	sasl := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      console.KafkaSASecretKey(consoleobj).Name,
+			Namespace: console.KafkaSASecretKey(consoleobj).Namespace,
+		},
+		Data: map[string][]byte{
+			corev1.BasicAuthUsernameKey: []byte("console"),
+			corev1.BasicAuthPasswordKey: []byte("password"),
+		},
+	}
+	require.NoError(t, client.IgnoreAlreadyExists(c.Create(context.Background(), sasl)))
+}
+
+type stubSRVResolver struct {
+	addrs []*net.SRV
+}
+
+func (r *stubSRVResolver) LookupSRV(
+	_ context.Context, _, _, _ string,
+) (string, []*net.SRV, error) {
+	return "", r.addrs, nil
+}
+
+func TestGenerateConfig_SRVRecord(t *testing.T) {
+	resolver := &stubSRVResolver{addrs: []*net.SRV{
+		{Target: "broker-0.example.com.", Port: 9092},
+		{Target: "broker-1.example.com.", Port: 9093},
+	}}
+	brokers, err := console.ResolveSRVBrokers(context.Background(), resolver, "_kafka._tcp.example.com")
+	require.NoError(t, err)
+
+	consoleobj := testConsole()
+	consoleobj.Spec.Kafka.SRVRecord = "_kafka._tcp.example.com"
+	consoleobj.Status.ResolvedBrokers = brokers
+
+	cc := ensureConfig(t, fake.NewClientBuilder().Build(), consoleobj, testCluster())
+	assert.Equal(t, []string{"broker-0.example.com:9092", "broker-1.example.com:9093"}, cc.Kafka.Brokers)
+}
+
+func TestResolveSRVBrokers_StableOrder(t *testing.T) {
+	ctx := context.Background()
+	resolver := &stubSRVResolver{addrs: []*net.SRV{
+		{Target: "broker-1.example.com.", Port: 9092, Priority: 10, Weight: 50},
+		{Target: "broker-0.example.com.", Port: 9092, Priority: 10, Weight: 50},
+		{Target: "broker-2.example.com.", Port: 9092, Priority: 20, Weight: 10},
+	}}
+	first, err := console.ResolveSRVBrokers(ctx, resolver, "_kafka._tcp.example.com")
+	require.NoError(t, err)
+
+	// Records of equal priority are returned in a different order on the next lookup
+	resolver.addrs[0], resolver.addrs[1], resolver.addrs[2] = resolver.addrs[2], resolver.addrs[0], resolver.addrs[1]
+	second, err := console.ResolveSRVBrokers(ctx, resolver, "_kafka._tcp.example.com")
+	require.NoError(t, err)
+
+	expected := []string{"broker-0.example.com:9092", "broker-1.example.com:9092", "broker-2.example.com:9092"}
+	assert.Equal(t, expected, first)
+	assert.Equal(t, expected, second)
+}
+
+func TestGetSRVRefreshInterval(t *testing.T) {
+	consoleobj := testConsole()
+	consoleobj.Spec.Kafka.SRVRecord = "_kafka._tcp.example.com"
+
+	// Not defaulted by the API server, e.g. Console stored before the field was added
+	assert.Equal(t, console.DefaultSRVRefreshInterval, console.GetSRVRefreshInterval(consoleobj))
+
+	consoleobj.Spec.Kafka.SRVRefreshInterval = &metav1.Duration{Duration: time.Minute}
+	assert.Equal(t, time.Minute, console.GetSRVRefreshInterval(consoleobj))
+}
+
+func TestGenerateConfig_ProducerAcks(t *testing.T) {
+	consoleobj := testConsole()
+	consoleobj.Spec.Kafka.Producer = &redpandav1alpha1.KafkaProducer{Acks: redpandav1alpha1.KafkaProducerAcksAll}
+
+	ensureConfigUnsupported(t, fake.NewClientBuilder().Build(), consoleobj, testCluster(), "producer")
+}
+
+func TestGenerateConfig_EmailDomainBindings(t *testing.T) {
+	consoleobj := testConsole()
+	consoleobj.Spec.Enterprise = &redpandav1alpha1.Enterprise{
+		RBAC: redpandav1alpha1.EnterpriseRBAC{
+			Enabled:         true,
+			RoleBindingsRef: corev1.LocalObjectReference{Name: "rbac"},
+			EmailDomainBindings: []redpandav1alpha1.DomainRoleBinding{
+				{Domain: "corp.com", RoleName: "viewer"},
+			},
+		},
+	}
+
+	ensureConfigUnsupported(t, fake.NewClientBuilder().Build(), consoleobj, testCluster(), "emailDomainBindings")
+}
+
+func TestGenerateConfig_MaxMessagesPerFetch(t *testing.T) {
+	consoleobj := testConsole()
+	consoleobj.Spec.Console.MaxMessagesPerFetch = 500
+
+	ensureConfigUnsupported(t, fake.NewClientBuilder().Build(), consoleobj, testCluster(), "console")
+}
+
+func TestGenerateConfig_Maintenance(t *testing.T) {
+	consoleobj := testConsole()
+	consoleobj.Spec.Server.MaintenanceMode = true
+	consoleobj.Spec.Server.MaintenanceMessage = "Upgrading, back soon"
+
+	c := fake.NewClientBuilder().Build()
+	ensureConfigUnsupported(t, c, consoleobj, testCluster(), "maintenanceMode", "maintenanceMessage")
+
+	// The condition set by the controller for the rejected config is cleared
+	consoleobj.Status.SetCondition(
+		redpandav1alpha1.ConfigUnsupportedConditionType, corev1.ConditionTrue,
+		redpandav1alpha1.ConfigUnsupportedReasonUnknownKeys, "",
+	)
+	consoleobj.Spec.Server.MaintenanceMode = false
+	consoleobj.Spec.Server.MaintenanceMessage = ""
+	cc := ensureConfig(t, c, consoleobj, testCluster())
+	assert.Equal(t, 8080, cc.Server.HTTPListenPort)
+	cond := consoleobj.Status.GetCondition(redpandav1alpha1.ConfigUnsupportedConditionType)
+	require.NotNil(t, cond)
+	assert.Equal(t, corev1.ConditionFalse, cond.Status)
+	assert.Equal(t, redpandav1alpha1.ConfigUnsupportedReasonSupported, cond.Reason)
+}
+
+func TestGenerateConfig_OfflineLicense(t *testing.T) {
+	ctx := context.Background()
+	consoleobj := testConsole()
+	consoleobj.Spec.LicenseRef = &redpandav1alpha1.LicenseSecretKeyRef{SecretKeyRef: redpandav1alpha1.SecretKeyRef{Name: "license", Namespace: "default"}}
+	consoleobj.Spec.LicenseOffline = true
+
+	// Only the fake client is available, rendering must not require network access
+	c := fake.NewClientBuilder().Build()
+	require.NoError(t, c.Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "license", Namespace: "default"},
+		Data:       map[string][]byte{console.DefaultLicenseSecretKey: []byte("offline-license-token")},
+	}))
+
+	cc := ensureConfig(t, c, consoleobj, testCluster())
+	assert.Equal(t, "offline-license-token", cc.License)
+
+	cond := consoleobj.Status.GetCondition(redpandav1alpha1.LicenseOfflineConditionType)
+	require.NotNil(t, cond)
+	assert.Equal(t, corev1.ConditionTrue, cond.Status)
+	assert.Equal(t, redpandav1alpha1.LicenseOfflineReasonOffline, cond.Reason)
+}
+
+func TestGenerateConfig_UIRefreshInterval(t *testing.T) {
+	consoleobj := testConsole()
+	consoleobj.Spec.Server.UI.RefreshInterval = &metav1.Duration{Duration: 10 * time.Second}
+
+	ensureConfigUnsupported(t, fake.NewClientBuilder().Build(), consoleobj, testCluster(), "ui")
+}
+
+func TestGenerateConfig_JWTRotation(t *testing.T) {
+	ctx := context.Background()
+	consoleobj := testConsole()
+	consoleobj.Spec.Login = &redpandav1alpha1.EnterpriseLogin{
+		Enabled:      true,
+		JWTSecretRef: redpandav1alpha1.SecretKeyRef{Name: "jwt", Namespace: "default"},
+		JWTRotation: &redpandav1alpha1.EnterpriseLoginJWTRotation{
+			AllowPreviousSecretRef: &redpandav1alpha1.SecretKeyRef{Name: "jwt-previous", Namespace: "default"},
+		},
+	}
+
+	c := fake.NewClientBuilder().Build()
+	require.NoError(t, c.Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "jwt", Namespace: "default"},
+		Data:       map[string][]byte{console.DefaultJWTSecretKey: []byte("current-key")},
+	}))
+	require.NoError(t, c.Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "jwt-previous", Namespace: "default"},
+		Data:       map[string][]byte{console.DefaultJWTSecretKey: []byte("previous-key")},
+	}))
+
+	ensureConfigUnsupported(t, c, consoleobj, testCluster(), "jwt")
+}
+
+func TestGenerateConfig_GoogleDirectoryRefreshInterval(t *testing.T) {
+	ctx := context.Background()
+	consoleobj := testConsole()
+	consoleobj.Spec.Login = &redpandav1alpha1.EnterpriseLogin{
+		Enabled:      true,
+		JWTSecretRef: redpandav1alpha1.SecretKeyRef{Name: "jwt", Namespace: "default"},
+		Google: &redpandav1alpha1.EnterpriseLoginGoogle{
+			Enabled:              true,
+			ClientCredentialsRef: redpandav1alpha1.NamespaceNameRef{Name: "google", Namespace: "default"},
+			Directory: &redpandav1alpha1.EnterpriseLoginGoogleDirectory{
+				ServiceAccountRef: corev1.LocalObjectReference{Name: "google-sa"},
+				TargetPrincipal:   "admin@corp.com",
+				RefreshInterval:   &metav1.Duration{Duration: 30 * time.Minute},
+			},
+		},
+	}
+
+	c := fake.NewClientBuilder().Build()
+	require.NoError(t, c.Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "jwt", Namespace: "default"},
+		Data:       map[string][]byte{console.DefaultJWTSecretKey: []byte("jwt")},
+	}))
+	require.NoError(t, c.Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "google", Namespace: "default"},
+		Data: map[string][]byte{
+			console.EnterpriseGoogleClientIDSecretKey: []byte("id"),
+			console.EnterpriseGoogleClientSecretKey:   []byte("secret"),
+		},
+	}))
+
+	ensureConfigUnsupported(t, c, consoleobj, testCluster(), "refreshInterval")
+
+	// Non-positive interval is rejected
+	consoleobj.Spec.Login.Google.Directory.RefreshInterval = &metav1.Duration{Duration: -time.Minute}
+	consoleobj.Status.ConfigMapRef = nil
+	cm := console.NewConfigMap(c, scheme.Scheme, consoleobj, testCluster(), ctrl.Log.WithName("test"))
+	assert.Error(t, cm.Ensure(ctx))
+}
+
+func TestGenerateConfig_RequestTimeoutOverrides(t *testing.T) {
+	consoleobj := testConsole()
+	consoleobj.Spec.Kafka.RequestTimeoutOverrides = map[string]string{
+		"DeleteRecords":             "2m",
+		"AlterPartitionAssignments": "30s",
+	}
+
+	c := fake.NewClientBuilder().Build()
+	ensureConfigUnsupported(t, c, consoleobj, testCluster(), "requestTimeoutOverrides")
+
+	// Invalid duration is rejected
+	consoleobj.Spec.Kafka.RequestTimeoutOverrides["DeleteRecords"] = "soon"
+	consoleobj.Status.ConfigMapRef = nil
+	cm := console.NewConfigMap(c, scheme.Scheme, consoleobj, testCluster(), ctrl.Log.WithName("test"))
+	assert.Error(t, cm.Ensure(context.Background()))
+}
+
+func TestGenerateConfig_AllowedRoles(t *testing.T) {
+	consoleobj := testConsole()
+	consoleobj.Spec.Enterprise = &redpandav1alpha1.Enterprise{
+		RBAC: redpandav1alpha1.EnterpriseRBAC{
+			Enabled:         true,
+			RoleBindingsRef: corev1.LocalObjectReference{Name: "rbac"},
+			AllowedRoles:    []string{"viewer", "editor"},
+		},
+	}
+
+	ensureConfigUnsupported(t, fake.NewClientBuilder().Build(), consoleobj, testCluster(), "allowedRoles")
+}
+
+func TestGenerateConfig_CaseInsensitiveSubjects(t *testing.T) {
+	consoleobj := testConsole()
+	consoleobj.Spec.Enterprise = &redpandav1alpha1.Enterprise{
+		RBAC: redpandav1alpha1.EnterpriseRBAC{
+			Enabled:         true,
+			RoleBindingsRef: corev1.LocalObjectReference{Name: "rbac"},
+		},
+	}
+
+	cc := ensureConfig(t, fake.NewClientBuilder().Build(), consoleobj, testCluster())
+	assert.False(t, cc.Enterprise.RBAC.CaseInsensitiveSubjects)
+
+	consoleobj.Spec.Enterprise.RBAC.CaseInsensitiveSubjects = true
+	ensureConfigUnsupported(t, fake.NewClientBuilder().Build(), consoleobj, testCluster(), "caseInsensitiveSubjects")
+}
+
+func TestGenerateConfig_HiddenConnectorClasses(t *testing.T) {
+	consoleobj := testConsole()
+	consoleobj.Spec.Connect.Enabled = true
+	consoleobj.Spec.Connect.Clusters = []redpandav1alpha1.ConnectCluster{
+		{
+			Name:                   "connect",
+			URL:                    "http://connect:8083",
+			HiddenConnectorClasses: []string{"org.apache.kafka.connect.mirror.MirrorSourceConnector"},
+		},
+	}
+
+	ensureConfigUnsupported(t, fake.NewClientBuilder().Build(), consoleobj, testCluster(), "hiddenConnectorClasses")
+}
+
+func TestGenerateConfig_KafkaProxy(t *testing.T) {
+	ctx := context.Background()
+	consoleobj := testConsole()
+	consoleobj.Spec.Kafka.Proxy = &redpandav1alpha1.KafkaProxy{
+		SOCKS5Address:  "proxy.example.com:1080",
+		CredentialsRef: &redpandav1alpha1.NamespaceNameRef{Name: "proxy", Namespace: "default"},
+	}
+
+	c := fake.NewClientBuilder().Build()
+	require.NoError(t, c.Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "proxy", Namespace: "default"},
+		Data: map[string][]byte{
+			corev1.BasicAuthUsernameKey: []byte("proxy-user"),
+			corev1.BasicAuthPasswordKey: []byte("proxy-password"),
+		},
+	}))
+
+	ensureConfigUnsupported(t, c, consoleobj, testCluster(), "proxy")
+}
+
+func TestGenerateConfig_CompressionLevel(t *testing.T) {
+	consoleobj := testConsole()
+	consoleobj.Spec.Server.CompressionLevel = 9
+
+	cc := ensureConfig(t, fake.NewClientBuilder().Build(), consoleobj, testCluster())
+	assert.Equal(t, 9, cc.Server.CompressionLevel)
+}
+
+func TestGenerateConfig_KafkaHTTPProxyURL(t *testing.T) {
+	consoleobj := testConsole()
+	consoleobj.Spec.Kafka.HTTPProxyURL = "http://proxy.example.com:3128"
+
+	ensureConfigUnsupported(t, fake.NewClientBuilder().Build(), consoleobj, testCluster(), "httpProxyUrl")
+}
+
+func TestGenerateConfig_AllowSubjectDeletion(t *testing.T) {
+	consoleobj := testConsole()
+	consoleobj.Spec.SchemaRegistry.AllowSubjectDeletion = true
+
+	ensureConfigUnsupported(t, fake.NewClientBuilder().Build(), consoleobj, testCluster(), "authorization")
+}
+
+func TestGenerateConfig_RackAwareConsumer(t *testing.T) {
+	consoleobj := testConsole()
+	consoleobj.Spec.Kafka.Consumer = &redpandav1alpha1.KafkaConsumer{RackAware: true}
+
+	ensureConfigUnsupported(t, fake.NewClientBuilder().Build(), consoleobj, testCluster(), "enableRackAwareConsumer")
+}
+
+func TestGenerateConfig_MaxPollRecords(t *testing.T) {
+	consoleobj := testConsole()
+	consoleobj.Spec.Kafka.MaxPollRecords = 500
+
+	ensureConfigUnsupported(t, fake.NewClientBuilder().Build(), consoleobj, testCluster(), "maxPollRecords")
+}
+
+func TestGenerateConfig_SchemaRegistryRequestTimeout(t *testing.T) {
+	consoleobj := testConsole()
+	consoleobj.Spec.SchemaRegistry = redpandav1alpha1.Schema{Enabled: true, RequestTimeout: &metav1.Duration{Duration: 45 * time.Second}}
+
+	c := fake.NewClientBuilder().Build()
+	ensureConfigUnsupported(t, c, consoleobj, testCluster(), "requestTimeout")
+
+	// Non-positive duration is rejected
+	consoleobj.Spec.SchemaRegistry.RequestTimeout = &metav1.Duration{}
+	consoleobj.Status.ConfigMapRef = nil
+	cm := console.NewConfigMap(c, scheme.Scheme, consoleobj, testCluster(), ctrl.Log.WithName("test"))
+	assert.Error(t, cm.Ensure(context.Background()))
+}
+
+func TestGenerateConfig_SchemaRegistryPaginationSize(t *testing.T) {
+	consoleobj := testConsole()
+	consoleobj.Spec.SchemaRegistry = redpandav1alpha1.Schema{Enabled: true, PaginationSize: 200}
+
+	ensureConfigUnsupported(t, fake.NewClientBuilder().Build(), consoleobj, testCluster(), "paginationSize")
+
+	// Not rendered if Schema Registry is disabled
+	consoleobj.Spec.SchemaRegistry.Enabled = false
+	cc := ensureConfig(t, fake.NewClientBuilder().Build(), consoleobj, testCluster())
+	assert.Zero(t, cc.Kafka.Schema.PaginationSize)
+}
+
+func TestGenerateConfig_StatsRefreshInterval(t *testing.T) {
+	consoleobj := testConsole()
+	consoleobj.Spec.Console.StatsRefreshInterval = &metav1.Duration{Duration: 5 * time.Minute}
+
+	c := fake.NewClientBuilder().Build()
+	ensureConfigUnsupported(t, c, consoleobj, testCluster(), "console")
+
+	// Non-positive duration is rejected
+	consoleobj.Spec.Console.StatsRefreshInterval = &metav1.Duration{}
+	consoleobj.Status.ConfigMapRef = nil
+	cm := console.NewConfigMap(c, scheme.Scheme, consoleobj, testCluster(), ctrl.Log.WithName("test"))
+	assert.Error(t, cm.Ensure(context.Background()))
+}
+
+func TestGenerateConfig_BrandingEnvironmentLabel(t *testing.T) {
+	consoleobj := testConsole()
+	consoleobj.Spec.Console.Branding = &redpandav1alpha1.ConsoleBranding{EnvironmentLabel: "production"}
+
+	ensureConfigUnsupported(t, fake.NewClientBuilder().Build(), consoleobj, testCluster(), "console")
+}
+
+func TestGenerateConfig_DisableTelemetry(t *testing.T) {
+	consoleobj := testConsole()
+	consoleobj.Spec.Console.DisableTelemetry = true
+
+	ensureConfigUnsupported(t, fake.NewClientBuilder().Build(), consoleobj, testCluster(), "console")
+}
+
+func TestGenerateConfig_AdditionalScopes(t *testing.T) {
+	ctx := context.Background()
+	consoleobj := testConsole()
+	consoleobj.Spec.Login = &redpandav1alpha1.EnterpriseLogin{
+		Enabled:      true,
+		JWTSecretRef: redpandav1alpha1.SecretKeyRef{Name: "jwt", Namespace: "default"},
+		Google: &redpandav1alpha1.EnterpriseLoginGoogle{
+			Enabled:              true,
+			ClientCredentialsRef: redpandav1alpha1.NamespaceNameRef{Name: "google", Namespace: "default"},
+			AdditionalScopes:     []string{"groups", "offline_access"},
+		},
+	}
+
+	c := fake.NewClientBuilder().Build()
+	require.NoError(t, c.Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "jwt", Namespace: "default"},
+		Data:       map[string][]byte{console.DefaultJWTSecretKey: []byte("jwt")},
+	}))
+	require.NoError(t, c.Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "google", Namespace: "default"},
+		Data: map[string][]byte{
+			console.EnterpriseGoogleClientIDSecretKey: []byte("id"),
+			console.EnterpriseGoogleClientSecretKey:   []byte("secret"),
+		},
+	}))
+
+	ensureConfigUnsupported(t, c, consoleobj, testCluster(), "additionalScopes")
+}
+
+func TestGenerateConfig_RequireMFAClaim(t *testing.T) {
+	ctx := context.Background()
+	consoleobj := testConsole()
+	consoleobj.Spec.Login = &redpandav1alpha1.EnterpriseLogin{
+		Enabled:      true,
+		JWTSecretRef: redpandav1alpha1.SecretKeyRef{Name: "jwt", Namespace: "default"},
+		Google: &redpandav1alpha1.EnterpriseLoginGoogle{
+			Enabled:              true,
+			ClientCredentialsRef: redpandav1alpha1.NamespaceNameRef{Name: "google", Namespace: "default"},
+			RequireMFAClaim:      "amr",
+		},
+		RedpandaCloud: &redpandav1alpha1.EnterpriseLoginRedpandaCloud{
+			Enabled:         true,
+			RequireMFAClaim: "acr",
+		},
+	}
+
+	c := fake.NewClientBuilder().Build()
+	require.NoError(t, c.Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "jwt", Namespace: "default"},
+		Data:       map[string][]byte{console.DefaultJWTSecretKey: []byte("jwt")},
+	}))
+	require.NoError(t, c.Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "google", Namespace: "default"},
+		Data: map[string][]byte{
+			console.EnterpriseGoogleClientIDSecretKey: []byte("id"),
+			console.EnterpriseGoogleClientSecretKey:   []byte("secret"),
+		},
+	}))
+
+	// RedpandaCloud takes precedence over Google
+	ensureConfigUnsupported(t, c, consoleobj, testCluster(), "requireMfaClaim")
+
+	consoleobj.Spec.Login.RedpandaCloud = nil
+	ensureConfigUnsupported(t, c, consoleobj, testCluster(), "requireMfaClaim")
+}
+
+func TestGenerateConfig_LoginOIDC(t *testing.T) {
+	ctx := context.Background()
+	consoleobj := testConsole()
+	consoleobj.Spec.Login = &redpandav1alpha1.EnterpriseLogin{
+		Enabled:      true,
+		JWTSecretRef: redpandav1alpha1.SecretKeyRef{Name: "jwt", Namespace: "default"},
+		OIDC: &redpandav1alpha1.EnterpriseLoginOIDC{
+			Enabled:              true,
+			Issuer:               "https://auth.corp.com/realms/console",
+			ClientCredentialsRef: redpandav1alpha1.NamespaceNameRef{Name: "oidc", Namespace: "default"},
+			Scopes:               []string{"openid", "email", "groups"},
+			DisplayName:          "Corp SSO",
+		},
+	}
+
+	c := fake.NewClientBuilder().Build()
+	require.NoError(t, c.Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "jwt", Namespace: "default"},
+		Data:       map[string][]byte{console.DefaultJWTSecretKey: []byte("jwt")},
+	}))
+	require.NoError(t, c.Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "oidc", Namespace: "default"},
+		Data: map[string][]byte{
+			console.EnterpriseOIDCClientIDSecretKey: []byte("id"),
+			console.EnterpriseOIDCClientSecretKey:   []byte("secret"),
+		},
+	}))
+
+	ensureConfigUnsupported(t, c, consoleobj, testCluster(), "oidc")
+
+	// RedpandaCloud takes precedence over OIDC
+	consoleobj.Spec.Login.RedpandaCloud = &redpandav1alpha1.EnterpriseLoginRedpandaCloud{Enabled: true}
+	cc := ensureConfig(t, c, consoleobj, testCluster())
+	require.NotNil(t, cc.Login.RedpandaCloud)
+	assert.Nil(t, cc.Login.OIDC)
+
+	// Disabled Google doesn't take precedence over OIDC
+	consoleobj.Spec.Login.RedpandaCloud = nil
+	consoleobj.Spec.Login.Google = &redpandav1alpha1.EnterpriseLoginGoogle{
+		Enabled:              false,
+		ClientCredentialsRef: redpandav1alpha1.NamespaceNameRef{Name: "google", Namespace: "default"},
+	}
+	ensureConfigUnsupported(t, c, consoleobj, testCluster(), "oidc")
+
+	// Disabled OIDC is not rendered
+	consoleobj.Spec.Login.Google = nil
+	consoleobj.Spec.Login.OIDC.Enabled = false
+	cc = ensureConfig(t, c, consoleobj, testCluster())
+	assert.Nil(t, cc.Login.OIDC)
+}
+
+func TestGenerateConfig_LoginGoogleWithOIDC(t *testing.T) {
+	ctx := context.Background()
+	consoleobj := testConsole()
+	consoleobj.Spec.Login = &redpandav1alpha1.EnterpriseLogin{
+		Enabled:      true,
+		JWTSecretRef: redpandav1alpha1.SecretKeyRef{Name: "jwt", Namespace: "default"},
+		Google: &redpandav1alpha1.EnterpriseLoginGoogle{
+			Enabled:              true,
+			ClientCredentialsRef: redpandav1alpha1.NamespaceNameRef{Name: "google", Namespace: "default"},
+		},
+		OIDC: &redpandav1alpha1.EnterpriseLoginOIDC{
+			Enabled:              true,
+			Issuer:               "https://auth.corp.com/realms/console",
+			ClientCredentialsRef: redpandav1alpha1.NamespaceNameRef{Name: "oidc", Namespace: "default"},
+		},
+	}
+
+	c := fake.NewClientBuilder().Build()
+	require.NoError(t, c.Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "jwt", Namespace: "default"},
+		Data:       map[string][]byte{console.DefaultJWTSecretKey: []byte("jwt")},
+	}))
+	require.NoError(t, c.Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "google", Namespace: "default"},
+		Data: map[string][]byte{
+			console.EnterpriseGoogleClientIDSecretKey: []byte("123456789012-abc.apps.googleusercontent.com"),
+			console.EnterpriseGoogleClientSecretKey:   []byte("secret"),
+		},
+	}))
+	require.NoError(t, c.Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "oidc", Namespace: "default"},
+		Data: map[string][]byte{
+			console.EnterpriseOIDCClientIDSecretKey: []byte("id"),
+			console.EnterpriseOIDCClientSecretKey:   []byte("secret"),
+		},
+	}))
+
+	// OIDC is rendered along with Google, only OIDC is unknown to Console
+	ensureConfigUnsupported(t, c, consoleobj, testCluster(), "oidc")
+	cond := consoleobj.Status.GetCondition(redpandav1alpha1.LoginClientIDInvalidConditionType)
+	require.NotNil(t, cond, "Google is rendered")
+	assert.Equal(t, corev1.ConditionFalse, cond.Status)
+
+	// Google alone is supported
+	consoleobj.Spec.Login.OIDC.Enabled = false
+	cc := ensureConfig(t, c, consoleobj, testCluster())
+	require.NotNil(t, cc.Login.Google)
+	assert.Equal(t, "123456789012-abc.apps.googleusercontent.com", cc.Login.Google.ClientID)
+	assert.Nil(t, cc.Login.OIDC)
+}
+
+func TestGenerateConfig_LoginGitHub(t *testing.T) {
+	ctx := context.Background()
+	consoleobj := testConsole()
+	consoleobj.Spec.Login = &redpandav1alpha1.EnterpriseLogin{
+		Enabled:      true,
+		JWTSecretRef: redpandav1alpha1.SecretKeyRef{Name: "jwt", Namespace: "default"},
+		GitHub: &redpandav1alpha1.EnterpriseLoginGitHub{
+			Enabled:              true,
+			ClientCredentialsRef: redpandav1alpha1.NamespaceNameRef{Name: "github", Namespace: "default"},
+			OrganizationsRef:     &corev1.LocalObjectReference{Name: "github-orgs"},
+		},
+	}
+
+	c := fake.NewClientBuilder().Build()
+	require.NoError(t, c.Create(ctx, consoleobj))
+	require.NoError(t, c.Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "jwt", Namespace: "default"},
+		Data:       map[string][]byte{console.DefaultJWTSecretKey: []byte("jwt")},
+	}))
+	require.NoError(t, c.Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "github", Namespace: "default"},
+		Data: map[string][]byte{
+			console.EnterpriseGitHubClientIDSecretKey: []byte("id"),
+			console.EnterpriseGitHubClientSecretKey:   []byte("secret"),
+		},
+	}))
+	require.NoError(t, c.Create(ctx, &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "github-orgs", Namespace: "default"},
+		Data:       map[string]string{console.EnterpriseGitHubOrganizationsDataKey: "redpanda-data\n vectorizedio\n\n"},
+	}))
+
+	ensureConfigUnsupported(t, c, consoleobj, testCluster(), "github")
+
+	// Missing organizations ConfigMap degrades the provider instead of rendering it without the restriction
+	consoleobj.Spec.Login.GitHub.OrganizationsRef.Name = "missing"
+	consoleobj.Status.ConfigMapRef = nil
+	err := console.NewConfigMap(c, scheme.Scheme, consoleobj, testCluster(), ctrl.Log.WithName("test")).Ensure(ctx)
+	var ce *console.ConditionError
+	require.True(t, errors.As(err, &ce), "%v", err)
+	assert.Equal(t, redpandav1alpha1.LoginProviderDegradedConditionType, ce.Type)
+	assert.Equal(t, redpandav1alpha1.LoginProviderDegradedReasonOrganizationsNotFound, ce.Reason)
+	assert.Contains(t, ce.Message, "default/missing")
+
+	// Status is updated by the controller, not while the config is generated
+	actual := &redpandav1alpha1.Console{}
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(consoleobj), actual))
+	assert.Nil(t, actual.Status.GetCondition(redpandav1alpha1.LoginProviderDegradedConditionType))
+}
+
+func TestGenerateConfig_LoginGitHubWithGoogle(t *testing.T) {
+	ctx := context.Background()
+	consoleobj := testConsole()
+	consoleobj.Spec.Login = &redpandav1alpha1.EnterpriseLogin{
+		Enabled:      true,
+		JWTSecretRef: redpandav1alpha1.SecretKeyRef{Name: "jwt", Namespace: "default"},
+		Google: &redpandav1alpha1.EnterpriseLoginGoogle{
+			Enabled:              true,
+			ClientCredentialsRef: redpandav1alpha1.NamespaceNameRef{Name: "google", Namespace: "default"},
+		},
+		GitHub: &redpandav1alpha1.EnterpriseLoginGitHub{
+			Enabled:              true,
+			ClientCredentialsRef: redpandav1alpha1.NamespaceNameRef{Name: "github", Namespace: "default"},
+			Teams:                []string{"redpanda-data/core"},
+		},
+	}
+
+	c := fake.NewClientBuilder().Build()
+	require.NoError(t, c.Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "jwt", Namespace: "default"},
+		Data:       map[string][]byte{console.DefaultJWTSecretKey: []byte("jwt")},
+	}))
+	require.NoError(t, c.Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "google", Namespace: "default"},
+		Data: map[string][]byte{
+			console.EnterpriseGoogleClientIDSecretKey: []byte("google-id"),
+			console.EnterpriseGoogleClientSecretKey:   []byte("google-secret"),
+		},
+	}))
+	require.NoError(t, c.Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "github", Namespace: "default"},
+		Data: map[string][]byte{
+			console.EnterpriseGitHubClientIDSecretKey: []byte("github-id"),
+			console.EnterpriseGitHubClientSecretKey:   []byte("github-secret"),
+		},
+	}))
+
+	// Both providers are rendered
+	ensureConfigUnsupported(t, c, consoleobj, testCluster(), "github")
+
+	// RedpandaCloud takes precedence over both
+	consoleobj.Spec.Login.RedpandaCloud = &redpandav1alpha1.EnterpriseLoginRedpandaCloud{Enabled: true}
+	cc := ensureConfig(t, c, consoleobj, testCluster())
+	require.NotNil(t, cc.Login.RedpandaCloud)
+	assert.Nil(t, cc.Login.Google)
+	assert.Nil(t, cc.Login.GitHub)
+}
+
+func TestGenerateConfig_GoogleClientIDMalformed(t *testing.T) {
+	ctx := context.Background()
+	consoleobj := testConsole()
+	consoleobj.Spec.Login = &redpandav1alpha1.EnterpriseLogin{
+		Enabled:      true,
+		JWTSecretRef: redpandav1alpha1.SecretKeyRef{Name: "jwt", Namespace: "default"},
+		Google: &redpandav1alpha1.EnterpriseLoginGoogle{
+			Enabled:              true,
+			ClientCredentialsRef: redpandav1alpha1.NamespaceNameRef{Name: "google", Namespace: "default"},
+		},
+	}
+
+	google := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "google", Namespace: "default"},
+		Data: map[string][]byte{
+			console.EnterpriseGoogleClientIDSecretKey: []byte("id"),
+			console.EnterpriseGoogleClientSecretKey:   []byte("secret"),
+		},
+	}
+	c := fake.NewClientBuilder().Build()
+	require.NoError(t, c.Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "jwt", Namespace: "default"},
+		Data:       map[string][]byte{console.DefaultJWTSecretKey: []byte("jwt")},
+	}))
+	require.NoError(t, c.Create(ctx, google))
+
+	// Malformed client ID is reported, config is still generated
+	cc := ensureConfig(t, c, consoleobj, testCluster())
+	require.NotNil(t, cc.Login.Google)
+	assert.Equal(t, "id", cc.Login.Google.ClientID)
+	cond := consoleobj.Status.GetCondition(redpandav1alpha1.LoginClientIDInvalidConditionType)
+	require.NotNil(t, cond)
+	assert.Equal(t, corev1.ConditionTrue, cond.Status)
+	assert.Equal(t, redpandav1alpha1.LoginClientIDInvalidReasonMalformed, cond.Reason)
+	assert.Contains(t, cond.Message, `"id"`)
+
+	// Valid client ID clears the condition
+	google.Data[console.EnterpriseGoogleClientIDSecretKey] = []byte("123456789012-abc123.apps.googleusercontent.com")
+	require.NoError(t, c.Update(ctx, google))
+	ensureConfig(t, c, consoleobj, testCluster())
+	cond = consoleobj.Status.GetCondition(redpandav1alpha1.LoginClientIDInvalidConditionType)
+	require.NotNil(t, cond)
+	assert.Equal(t, corev1.ConditionFalse, cond.Status)
+	assert.Equal(t, redpandav1alpha1.LoginClientIDInvalidReasonValid, cond.Reason)
+}
+
+func TestGenerateConfig_ClaimMappings(t *testing.T) {
+	ctx := context.Background()
+	consoleobj := testConsole()
+	consoleobj.Spec.Login = &redpandav1alpha1.EnterpriseLogin{
+		Enabled:      true,
+		JWTSecretRef: redpandav1alpha1.SecretKeyRef{Name: "jwt", Namespace: "default"},
+		Google: &redpandav1alpha1.EnterpriseLoginGoogle{
+			Enabled:              true,
+			ClientCredentialsRef: redpandav1alpha1.NamespaceNameRef{Name: "google", Namespace: "default"},
+			ClaimMappings:        map[string]string{"name": "given_name"},
+		},
+		RedpandaCloud: &redpandav1alpha1.EnterpriseLoginRedpandaCloud{
+			Enabled:       true,
+			ClaimMappings: map[string]string{"email": "upn", "name": "display_name"},
+		},
+	}
+
+	c := fake.NewClientBuilder().Build()
+	require.NoError(t, c.Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "jwt", Namespace: "default"},
+		Data:       map[string][]byte{console.DefaultJWTSecretKey: []byte("jwt")},
+	}))
+	require.NoError(t, c.Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "google", Namespace: "default"},
+		Data: map[string][]byte{
+			console.EnterpriseGoogleClientIDSecretKey: []byte("id"),
+			console.EnterpriseGoogleClientSecretKey:   []byte("secret"),
+		},
+	}))
+
+	// RedpandaCloud takes precedence over Google
+	ensureConfigUnsupported(t, c, consoleobj, testCluster(), "claimMappings")
+
+	consoleobj.Spec.Login.RedpandaCloud = nil
+	ensureConfigUnsupported(t, c, consoleobj, testCluster(), "claimMappings")
+}
+
+func TestGenerateConfig_UsePKCE(t *testing.T) {
+	consoleobj := testConsole()
+	consoleobj.Spec.Login = &redpandav1alpha1.EnterpriseLogin{
+		Enabled:       true,
+		JWTSecretRef:  redpandav1alpha1.SecretKeyRef{Name: "jwt", Namespace: "default"},
+		RedpandaCloud: &redpandav1alpha1.EnterpriseLoginRedpandaCloud{Enabled: true, UsePKCE: true},
+	}
+
+	c := fake.NewClientBuilder().Build()
+	require.NoError(t, c.Create(context.Background(), &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "jwt", Namespace: "default"},
+		Data:       map[string][]byte{console.DefaultJWTSecretKey: []byte("jwt")},
+	}))
+
+	ensureConfigUnsupported(t, c, consoleobj, testCluster(), "usePkce")
+}
+
+func TestEnsureConfigMap_ConfigHashLabel(t *testing.T) {
+	require.NoError(t, redpandav1alpha1.AddToScheme(scheme.Scheme))
+	ctx := context.Background()
+
+	consoleobj := testConsole()
+	c := fake.NewClientBuilder().Build()
+	require.NoError(t, c.Create(ctx, consoleobj))
+	ensureConfig(t, c, consoleobj, testCluster())
+
+	hash := consoleobj.Status.ConfigHash
+	require.NotEmpty(t, hash)
+	cms := &corev1.ConfigMapList{}
+	require.NoError(t, c.List(ctx, cms,
+		client.InNamespace(consoleobj.GetNamespace()),
+		client.MatchingLabels{console.ConfigHashLabelKey: hash},
+	))
+	require.Len(t, cms.Items, 1)
+	assert.Equal(t, consoleobj.Status.ConfigMapRef.Name, cms.Items[0].GetName())
+}
+
+func TestEnsureConfigMap_ImmutableRecreatedOnChange(t *testing.T) {
+	require.NoError(t, redpandav1alpha1.AddToScheme(scheme.Scheme))
+	ctx := context.Background()
+
+	consoleobj := testConsole()
+	c := fake.NewClientBuilder().Build()
+	require.NoError(t, c.Create(ctx, consoleobj))
+	ensureConfig(t, c, consoleobj, testCluster())
+
+	previous := consoleobj.Status.ConfigMapRef.DeepCopy()
+	obj := &corev1.ConfigMap{}
+	require.NoError(t, c.Get(ctx, client.ObjectKey{Namespace: previous.Namespace, Name: previous.Name}, obj))
+	require.NotNil(t, obj.Immutable)
+	assert.True(t, *obj.Immutable)
+
+	// Config change creates a new ConfigMap, the previous one is deleted as unused
+	consoleobj.Spec.Server.CompressionLevel = 9
+	cc := ensureConfig(t, c, consoleobj, testCluster())
+	assert.Equal(t, 9, cc.Server.CompressionLevel)
+	assert.NotEqual(t, previous.Name, consoleobj.Status.ConfigMapRef.Name)
+	cm := console.NewConfigMap(c, scheme.Scheme, consoleobj, testCluster(), ctrl.Log.WithName("test"))
+	require.NoError(t, cm.DeleteUnused(ctx))
+	assert.True(t, apierrors.IsNotFound(c.Get(ctx, client.ObjectKey{Namespace: previous.Namespace, Name: previous.Name}, obj)))
+}
+
+func TestEnsureConfigMap_ConfigInSecret(t *testing.T) {
+	require.NoError(t, redpandav1alpha1.AddToScheme(scheme.Scheme))
+	ctx := context.Background()
+	log := ctrl.Log.WithName("test")
+
+	consoleobj := testConsole()
+	consoleobj.Spec.Deployment.ConfigInSecret = true
+	consoleobj.Status.ConfigMapRef = nil
+	cluster := testCluster()
+
+	c := fake.NewClientBuilder().Build()
+	require.NoError(t, c.Create(ctx, consoleobj))
+	require.NoError(t, c.Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      console.KafkaSASecretKey(consoleobj).Name,
+			Namespace: console.KafkaSASecretKey(consoleobj).Namespace,
+		},
+	}))
+	require.NoError(t, console.NewConfigMap(c, scheme.Scheme, consoleobj, cluster, log).Ensure(ctx))
+
+	ref := consoleobj.Status.ConfigMapRef
+	require.NotNil(t, ref)
+	assert.Equal(t, "Secret", ref.Kind)
+	secret := &corev1.Secret{}
+	require.NoError(t, c.Get(ctx, client.ObjectKey{Namespace: ref.Namespace, Name: ref.Name}, secret))
+	assert.Equal(t, "true", secret.GetLabels()[console.ConfigSecretLabelKey])
+	assert.Equal(t, consoleobj.Status.ConfigHash, secret.GetLabels()[console.ConfigHashLabelKey])
+	cc := decodeConfig(t, secret.Data["config.yaml"])
+	assert.Equal(t, []string{"cluster-0.cluster.default.svc.cluster.local:9092"}, cc.Kafka.Brokers)
+	cms := &corev1.ConfigMapList{}
+	require.NoError(t, c.List(ctx, cms, client.InNamespace(consoleobj.GetNamespace())))
+	assert.Empty(t, cms.Items)
+
+	require.NoError(t, console.NewDeployment(c, scheme.Scheme, consoleobj, cluster, console.NewStore(c), log).Ensure(ctx))
+	deployment := &appsv1.Deployment{}
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(consoleobj), deployment))
+	var config *corev1.Volume
+	for i, v := range deployment.Spec.Template.Spec.Volumes {
+		if v.Name == "config" {
+			config = &deployment.Spec.Template.Spec.Volumes[i]
+		}
+	}
+	require.NotNil(t, config)
+	assert.Nil(t, config.ConfigMap)
+	require.NotNil(t, config.Secret)
+	assert.Equal(t, ref.Name, config.Secret.SecretName)
+
+	// Unused config Secrets are deleted, other Console Secrets are kept
+	synced := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "console-schema-registry", Namespace: "default", Labels: labels.ForConsole(consoleobj)},
+	}
+	require.NoError(t, c.Create(ctx, synced))
+	unused := ref.DeepCopy()
+	consoleobj.Status.ConfigMapRef = nil
+	require.NoError(t, console.NewConfigMap(c, scheme.Scheme, consoleobj, cluster, log).Ensure(ctx))
+	require.NoError(t, console.NewConfigMap(c, scheme.Scheme, consoleobj, cluster, log).DeleteUnused(ctx))
+	assert.True(t, apierrors.IsNotFound(c.Get(ctx, client.ObjectKey{Namespace: unused.Namespace, Name: unused.Name}, &corev1.Secret{})))
+	assert.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(synced), &corev1.Secret{}))
+}
+
+func TestGenerateConfig_MaxConcurrentFetches(t *testing.T) {
+	consoleobj := testConsole()
+	consoleobj.Spec.Kafka.Consumer = &redpandav1alpha1.KafkaConsumer{MaxConcurrentFetches: 8}
+
+	ensureConfigUnsupported(t, fake.NewClientBuilder().Build(), consoleobj, testCluster(), "maxConcurrentFetches")
+}
+
+func TestGenerateConfig_LoginCallbackPath(t *testing.T) {
+	ctx := context.Background()
+	consoleobj := testConsole()
+	consoleobj.Spec.Login = &redpandav1alpha1.EnterpriseLogin{
+		Enabled:      true,
+		JWTSecretRef: redpandav1alpha1.SecretKeyRef{Name: "jwt", Namespace: "default"},
+		CallbackPath: "/console/auth/callbacks",
+	}
+
+	c := fake.NewClientBuilder().Build()
+	require.NoError(t, c.Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "jwt", Namespace: "default"},
+		Data:       map[string][]byte{console.DefaultJWTSecretKey: []byte("jwt")},
+	}))
+
+	ensureConfigUnsupported(t, c, consoleobj, testCluster(), "callbackPath")
+}
+
+func TestGenerateConfig_SupportedCompressionCodecs(t *testing.T) {
+	consoleobj := testConsole()
+	consoleobj.Spec.Kafka.SupportedCompressionCodecs = []redpandav1alpha1.KafkaCompressionCodec{
+		redpandav1alpha1.KafkaCompressionCodecGzip,
+		redpandav1alpha1.KafkaCompressionCodecZstd,
+	}
+
+	ensureConfigUnsupported(t, fake.NewClientBuilder().Build(), consoleobj, testCluster(), "supportedCompressionCodecs")
+}
+
+func TestGenerateConfig_AuditLogIncludeRequestBody(t *testing.T) {
+	consoleobj := testConsole()
+	consoleobj.Spec.Enterprise = &redpandav1alpha1.Enterprise{
+		RBAC: redpandav1alpha1.EnterpriseRBAC{
+			Enabled:         true,
+			RoleBindingsRef: corev1.LocalObjectReference{Name: "rbac"},
+		},
+		AuditLog: &redpandav1alpha1.EnterpriseAuditLog{Enabled: true, IncludeRequestBody: true},
+	}
+
+	ensureConfigUnsupported(t, fake.NewClientBuilder().Build(), consoleobj, testCluster(), "audit")
+}
+
+func TestGenerateConfig_Base64License(t *testing.T) {
+	ctx := context.Background()
+	consoleobj := testConsole()
+	consoleobj.Spec.LicenseRef = &redpandav1alpha1.LicenseSecretKeyRef{
+		SecretKeyRef: redpandav1alpha1.SecretKeyRef{Name: "license", Namespace: "default"},
+	}
+
+	license := "eyJ2ZXJzaW9uIjoxfQ.c2lnbmF0dXJl"
+	c := fake.NewClientBuilder().Build()
+	require.NoError(t, c.Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "license", Namespace: "default"},
+		Data:       map[string][]byte{console.DefaultLicenseSecretKey: []byte(base64.StdEncoding.EncodeToString([]byte(license)) + "\n")},
+	}))
+
+	// Base64 encoded license is detected
+	cc := ensureConfig(t, c, consoleobj, testCluster())
+	assert.Equal(t, license, cc.License)
+
+	consoleobj.Spec.LicenseRef.Encoding = redpandav1alpha1.LicenseEncodingBase64
+	cc = ensureConfig(t, c, consoleobj, testCluster())
+	assert.Equal(t, license, cc.License)
+
+	// Raw license is used as is
+	consoleobj.Spec.LicenseRef.Encoding = redpandav1alpha1.LicenseEncodingRaw
+	cc = ensureConfig(t, c, consoleobj, testCluster())
+	assert.Equal(t, base64.StdEncoding.EncodeToString([]byte(license)), strings.TrimSpace(cc.License))
+}
+
+func TestGenerateConfig_ServerTLSReloadInterval(t *testing.T) {
+	consoleobj := testConsole()
+	consoleobj.Spec.Server.TLS = &redpandav1alpha1.ServerTLS{
+		Enabled:        true,
+		SecretRef:      corev1.LocalObjectReference{Name: "console-tls"},
+		ReloadInterval: &metav1.Duration{Duration: 10 * time.Minute},
+	}
+
+	ensureConfigUnsupported(t, fake.NewClientBuilder().Build(), consoleobj, testCluster(), "tls")
+}
+
+func TestGenerateConfig_ConnectionMaxIdle(t *testing.T) {
+	consoleobj := testConsole()
+	consoleobj.Spec.Kafka.ConnectionMaxIdle = &metav1.Duration{Duration: 5 * time.Minute}
+
+	ensureConfigUnsupported(t, fake.NewClientBuilder().Build(), consoleobj, testCluster(), "connectionMaxIdle")
+}
+
+func TestGenerateConfig_BrokerTimeout(t *testing.T) {
+	consoleobj := testConsole()
+	consoleobj.Spec.Kafka.BrokerTimeout = &metav1.Duration{Duration: 15 * time.Second}
+	consoleobj.Spec.Kafka.RequestTimeoutOverrides = map[string]string{"DeleteRecords": "2m"}
+
+	ensureConfigUnsupported(t, fake.NewClientBuilder().Build(), consoleobj, testCluster(), "brokerTimeout", "requestTimeoutOverrides")
+}
+
+func TestGenerateConfig_AccessLogSampleRate(t *testing.T) {
+	ctx := context.Background()
+	consoleobj := testConsole()
+	consoleobj.Spec.Server.AccessLog = &redpandav1alpha1.ServerAccessLog{SampleRate: "0.25"}
+
+	c := fake.NewClientBuilder().Build()
+	ensureConfigUnsupported(t, c, consoleobj, testCluster(), "accessLog")
+
+	// All requests are logged by default
+	consoleobj.Spec.Server.AccessLog.SampleRate = ""
+	ensureConfigUnsupported(t, c, consoleobj, testCluster(), "accessLog")
+
+	// Rate out of range is rejected, e.g. if the CRD pattern is not enforced
+	for _, rate := range []string{"1.5", "-0.1"} {
+		consoleobj.Spec.Server.AccessLog.SampleRate = rate
+		consoleobj.Status.ConfigMapRef = nil
+		cm := console.NewConfigMap(c, scheme.Scheme, consoleobj, testCluster(), ctrl.Log.WithName("test"))
+		err := cm.Ensure(ctx)
+		require.Error(t, err, rate)
+		assert.Contains(t, err.Error(), "must be between 0 and 1", rate)
+	}
+
+	// Rate that is not a number is rejected
+	consoleobj.Spec.Server.AccessLog.SampleRate = "half"
+	consoleobj.Status.ConfigMapRef = nil
+	cm := console.NewConfigMap(c, scheme.Scheme, consoleobj, testCluster(), ctrl.Log.WithName("test"))
+	err := cm.Ensure(ctx)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "parsing access log sample rate")
+}
+
+func TestGenerateConfig_APIBasePath(t *testing.T) {
+	consoleobj := testConsole()
+	consoleobj.Spec.Server.BasePath = "console/"
+	consoleobj.Spec.Server.APIBasePath = "console-api/"
+
+	ensureConfigUnsupported(t, fake.NewClientBuilder().Build(), consoleobj, testCluster(), "apiBasePath")
+
+	// API base path is not derived from the UI base path
+	consoleobj.Spec.Server.APIBasePath = ""
+	cc := ensureConfig(t, fake.NewClientBuilder().Build(), consoleobj, testCluster())
+	assert.Equal(t, "console/", cc.Server.BasePath)
+	assert.Empty(t, cc.Server.APIBasePath)
+}
+
+func TestGenerateConfig_KafkaRetries(t *testing.T) {
+	consoleobj := testConsole()
+	retries := 5
+	consoleobj.Spec.Kafka.Retries = &retries
+	consoleobj.Spec.Kafka.RetryBackoff = &metav1.Duration{Duration: 250 * time.Millisecond}
+
+	ensureConfigUnsupported(t, fake.NewClientBuilder().Build(), consoleobj, testCluster(), "retries", "retryBackoff")
+
+	// Retries can be disabled
+	retries = 0
+	ensureConfigUnsupported(t, fake.NewClientBuilder().Build(), consoleobj, testCluster(), "retries")
+}
+
+func TestEnsureConfigMap_LoginCredentialKeyMissing(t *testing.T) {
+	require.NoError(t, redpandav1alpha1.AddToScheme(scheme.Scheme))
+	ctx := context.Background()
+
+	consoleobj := testConsole()
+	consoleobj.Status.ConfigMapRef = nil
+	consoleobj.Spec.Login = &redpandav1alpha1.EnterpriseLogin{
+		Enabled:      true,
+		JWTSecretRef: redpandav1alpha1.SecretKeyRef{Name: "jwt", Namespace: "default"},
+		Google: &redpandav1alpha1.EnterpriseLoginGoogle{
+			Enabled:              true,
+			ClientCredentialsRef: redpandav1alpha1.NamespaceNameRef{Name: "google", Namespace: "default"},
+		},
+	}
+
+	c := fake.NewClientBuilder().Build()
+	require.NoError(t, c.Create(ctx, consoleobj))
+	require.NoError(t, c.Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      console.KafkaSASecretKey(consoleobj).Name,
+			Namespace: console.KafkaSASecretKey(consoleobj).Namespace,
+		},
+	}))
+	require.NoError(t, c.Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "jwt", Namespace: "default"},
+		Data:       map[string][]byte{console.DefaultJWTSecretKey: []byte("jwt")},
+	}))
+	require.NoError(t, c.Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "google", Namespace: "default"},
+		Data:       map[string][]byte{console.EnterpriseGoogleClientIDSecretKey: []byte("id")},
+	}))
+
+	err := console.NewConfigMap(c, scheme.Scheme, consoleobj, testCluster(), ctrl.Log.WithName("test")).Ensure(ctx)
+	var ce *console.ConditionError
+	require.True(t, errors.As(err, &ce), "%v", err)
+	assert.Equal(t, redpandav1alpha1.LoginCredentialKeyMissingConditionType, ce.Type)
+	assert.Equal(t, redpandav1alpha1.LoginCredentialKeyMissingReasonMissing, ce.Reason)
+	assert.Contains(t, ce.Message, console.EnterpriseGoogleClientSecretKey)
+	assert.NotContains(t, ce.Message, console.EnterpriseGoogleClientIDSecretKey)
+
+	// Status is updated by the controller, not while the config is generated
+	actual := &redpandav1alpha1.Console{}
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(consoleobj), actual))
+	assert.Nil(t, actual.Status.GetCondition(redpandav1alpha1.LoginCredentialKeyMissingConditionType))
+}
+
+func TestGenerateConfig_SchemaRegistryBasicAuthCrossNamespace(t *testing.T) {
+	require.NoError(t, redpandav1alpha1.AddToScheme(scheme.Scheme))
+	ctx := context.Background()
+
+	consoleobj := testConsole()
+	consoleobj.Spec.SchemaRegistry = redpandav1alpha1.Schema{
+		Enabled:      true,
+		BasicAuthRef: &redpandav1alpha1.SchemaBasicAuthRef{Name: "registry", Namespace: "shared"},
+	}
+
+	c := fake.NewClientBuilder().Build()
+	require.NoError(t, c.Create(ctx, consoleobj))
+
+	// Missing Secret in the other namespace is reported as unresolved
+	references := console.NewReferences(c, consoleobj, ctrl.Log.WithName("test"))
+	require.NoError(t, references.Ensure(ctx))
+	assert.Equal(t, []string{"Secret shared/registry"}, consoleobj.Status.UnresolvedRefs)
+
+	require.NoError(t, c.Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "registry", Namespace: "shared"},
+		Data: map[string][]byte{
+			corev1.BasicAuthUsernameKey: []byte("registry-user"),
+			corev1.BasicAuthPasswordKey: []byte("registry-password"),
+		},
+	}))
+	require.NoError(t, references.Ensure(ctx))
+	assert.Empty(t, consoleobj.Status.UnresolvedRefs)
+
+	cc := ensureConfig(t, c, consoleobj, testCluster())
+	assert.Equal(t, "registry-user", cc.Kafka.Schema.Username)
+	assert.Equal(t, "registry-password", cc.Kafka.Schema.Password)
+}
+
+func TestGenerateConfig_KafkaGroupTimeouts(t *testing.T) {
+	ctx := context.Background()
+	consoleobj := testConsole()
+	consoleobj.Spec.Kafka.SessionTimeout = &metav1.Duration{Duration: 45 * time.Second}
+	consoleobj.Spec.Kafka.HeartbeatInterval = &metav1.Duration{Duration: 3 * time.Second}
+
+	c := fake.NewClientBuilder().Build()
+	ensureConfigUnsupported(t, c, consoleobj, testCluster(), "sessionTimeout", "heartbeatInterval")
+
+	// Heartbeat interval not shorter than session timeout is rejected
+	consoleobj.Spec.Kafka.HeartbeatInterval = &metav1.Duration{Duration: 45 * time.Second}
+	consoleobj.Status.ConfigMapRef = nil
+	cm := console.NewConfigMap(c, scheme.Scheme, consoleobj, testCluster(), ctrl.Log.WithName("test"))
+	assert.Error(t, cm.Ensure(ctx))
+}
+
+func TestGenerateConfig_KafkaTLSRenegotiation(t *testing.T) {
+	consoleobj := testConsole()
+	consoleobj.Spec.Kafka.TLS = &redpandav1alpha1.KafkaTLS{Renegotiation: redpandav1alpha1.KafkaTLSRenegotiationOnce}
+
+	ensureConfigUnsupported(t, fake.NewClientBuilder().Build(), consoleobj, testCluster(), "renegotiation")
+}
+
+func TestGenerateConfig_ServeFrontend(t *testing.T) {
+	consoleobj := testConsole()
+	cc := ensureConfig(t, fake.NewClientBuilder().Build(), consoleobj, testCluster())
+	assert.True(t, cc.ServeFrontend)
+
+	consoleobj.Spec.ServeFrontend = false
+	cc = ensureConfig(t, fake.NewClientBuilder().Build(), consoleobj, testCluster())
+	assert.False(t, cc.ServeFrontend)
+}
+
+func TestGenerateConfig_TrustedProxyHops(t *testing.T) {
+	consoleobj := testConsole()
+	consoleobj.Spec.Server.TrustedProxyHops = 2
+
+	ensureConfigUnsupported(t, fake.NewClientBuilder().Build(), consoleobj, testCluster(), "trustedProxyHops")
+}
+
+func TestGenerateConfig_RequestIDHeader(t *testing.T) {
+	consoleobj := testConsole()
+	consoleobj.Spec.Server.RequestIDHeader = "X-Request-ID"
+
+	ensureConfigUnsupported(t, fake.NewClientBuilder().Build(), consoleobj, testCluster(), "requestIdHeader")
+}
+
+func TestGenerateConfig_MetricsAuth(t *testing.T) {
+	ctx := context.Background()
+	consoleobj := testConsole()
+	consoleobj.Spec.Server.MetricsAuth = &redpandav1alpha1.ServerMetricsAuth{
+		BasicAuthRef: redpandav1alpha1.NamespaceNameRef{Name: "metrics-auth", Namespace: "monitoring"},
+	}
+
+	c := fake.NewClientBuilder().Build()
+	require.NoError(t, c.Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "metrics-auth", Namespace: "monitoring"},
+		Data: map[string][]byte{
+			corev1.BasicAuthUsernameKey: []byte("prometheus"),
+			corev1.BasicAuthPasswordKey: []byte("scrape"),
+		},
+	}))
+
+	ensureConfigUnsupported(t, c, consoleobj, testCluster(), "metricsAuth")
+}
+
+func TestGenerateConfig_RequestID(t *testing.T) {
+	consoleobj := testConsole()
+	consoleobj.Spec.Server.RequestID = &redpandav1alpha1.ServerRequestID{Enabled: true, HeaderName: "X-Trace-ID"}
+
+	ensureConfigUnsupported(t, fake.NewClientBuilder().Build(), consoleobj, testCluster(), "requestId")
+
+	consoleobj.Spec.Server.RequestID.HeaderName = ""
+	consoleobj.Spec.Server.RequestIDHeader = "X-Request-ID"
+	ensureConfigUnsupported(t, fake.NewClientBuilder().Build(), consoleobj, testCluster(), "requestId", "requestIdHeader")
+}
+
+func TestGenerateConfig_SchemaRegistryInlineUsername(t *testing.T) {
+	ctx := context.Background()
+	consoleobj := testConsole()
+	consoleobj.Spec.SchemaRegistry = redpandav1alpha1.Schema{
+		Enabled:     true,
+		Username:    "registry-user",
+		PasswordRef: &redpandav1alpha1.SecretKeyRef{Name: "registry-password", Namespace: "default", Key: "secret"},
+	}
+
+	c := fake.NewClientBuilder().Build()
+	require.NoError(t, c.Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "registry-password", Namespace: "default"},
+		Data:       map[string][]byte{"secret": []byte("registry-password")},
+	}))
+
+	cc := ensureConfig(t, c, consoleobj, testCluster())
+	assert.Equal(t, "registry-user", cc.Kafka.Schema.Username)
+	assert.Equal(t, "registry-password", cc.Kafka.Schema.Password)
+}
+
+func TestGenerateConfig_SASLHandshakeVersion(t *testing.T) {
+	ctx := context.Background()
+	consoleobj := testConsole()
+	handshakeVersion := 0
+	consoleobj.Spec.Kafka.SASL = &redpandav1alpha1.KafkaSASL{
+		Mechanism:        redpandav1alpha1.KafkaSASLMechanismPlain,
+		CredentialsRef:   redpandav1alpha1.NamespaceNameRef{Name: "kafka-plain", Namespace: "default"},
+		HandshakeVersion: &handshakeVersion,
+	}
+
+	c := fake.NewClientBuilder().Build()
+	require.NoError(t, c.Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "kafka-plain", Namespace: "default"},
+		Data: map[string][]byte{
+			corev1.BasicAuthUsernameKey: []byte("external"),
+			corev1.BasicAuthPasswordKey: []byte("secret"),
+		},
+	}))
+
+	ensureConfigUnsupported(t, c, consoleobj, testCluster(), "handshakeVersion")
+}
+
+func TestGenerateConfig_LoginSessionIdleTimeout(t *testing.T) {
+	ctx := context.Background()
+	consoleobj := testConsole()
+	consoleobj.Spec.Login = &redpandav1alpha1.EnterpriseLogin{
+		Enabled:      true,
+		JWTSecretRef: redpandav1alpha1.SecretKeyRef{Name: "jwt", Namespace: "default"},
+		Session: &redpandav1alpha1.EnterpriseLoginSession{
+			Duration:    &metav1.Duration{Duration: 12 * time.Hour},
+			IdleTimeout: &metav1.Duration{Duration: 30 * time.Minute},
+		},
+		RedpandaCloud: &redpandav1alpha1.EnterpriseLoginRedpandaCloud{Enabled: true},
+	}
+
+	c := fake.NewClientBuilder().Build()
+	require.NoError(t, c.Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "jwt", Namespace: "default"},
+		Data:       map[string][]byte{console.DefaultJWTSecretKey: []byte("jwt")},
+	}))
+
+	ensureConfigUnsupported(t, c, consoleobj, testCluster(), "session")
+
+	// Idle timeout longer than the session duration is rejected
+	consoleobj.Spec.Login.Session.IdleTimeout = &metav1.Duration{Duration: 24 * time.Hour}
+	consoleobj.Status.ConfigMapRef = nil
+	cm := console.NewConfigMap(c, scheme.Scheme, consoleobj, testCluster(), ctrl.Log.WithName("test"))
+	assert.Error(t, cm.Ensure(ctx))
+}
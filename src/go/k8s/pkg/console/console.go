@@ -11,11 +11,20 @@
 package console
 
 import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
 	"github.com/cloudhut/common/rest"
 	"github.com/redpanda-data/console/backend/pkg/connect"
 	"github.com/redpanda-data/console/backend/pkg/kafka"
+	"github.com/redpanda-data/console/backend/pkg/msgpack"
+	"github.com/redpanda-data/console/backend/pkg/proto"
+	"github.com/redpanda-data/console/backend/pkg/schema"
 
 	redpandav1alpha1 "github.com/redpanda-data/redpanda/src/go/k8s/apis/redpanda/v1alpha1"
+	"gopkg.in/yaml.v2"
 )
 
 const (
@@ -26,16 +35,31 @@ const (
 type ConsoleConfig struct {
 	// Grabbed from https://github.com/redpanda-data/console/
 	// Copying the config types because they don't have Enterprise fields and not all fields are supported yet
+	// Fields not supported by Console yet are rejected before the config is written, see checkSupportedConfig
 	MetricsNamespace string `json:"metricsNamespace" yaml:"metricsNamespace"`
 	ServeFrontend    bool   `json:"serveFrontend" yaml:"serveFrontend"`
 
-	Server  rest.Config    `json:"server" yaml:"server"`
-	Kafka   kafka.Config   `json:"kafka" yaml:"kafka"`
-	Connect connect.Config `json:"connect" yaml:"connect"`
+	Server  Server  `json:"server" yaml:"server"`
+	Kafka   Kafka   `json:"kafka" yaml:"kafka"`
+	Connect Connect `json:"connect" yaml:"connect"`
+
+	Console ConsoleSettings `json:"console,omitempty" yaml:"console,omitempty"`
 
 	License    string          `json:"license,omitempty" yaml:"license,omitempty"`
 	Enterprise Enterprise      `json:"enterprise,omitempty" yaml:"enterprise,omitempty"`
 	Login      EnterpriseLogin `json:"login,omitempty" yaml:"login,omitempty"`
+
+	Authorization Authorization `json:"authorization,omitempty" yaml:"authorization,omitempty"`
+}
+
+// Authorization is the Console config for gating operations
+type Authorization struct {
+	SchemaRegistry AuthorizationSchemaRegistry `json:"schemaRegistry,omitempty" yaml:"schemaRegistry,omitempty"`
+}
+
+// AuthorizationSchemaRegistry is the Console config for gating Schema Registry operations
+type AuthorizationSchemaRegistry struct {
+	AllowSubjectDeletion bool `json:"allowSubjectDeletion" yaml:"allowSubjectDeletion"`
 }
 
 // SetDefaults sets sane defaults
@@ -43,23 +67,358 @@ func (cc *ConsoleConfig) SetDefaults() {
 	cc.Kafka.SetDefaults()
 }
 
+// supportedConfig is the config accepted by the Console version the operator is built against
+// Console refuses to start if the config has unknown keys
+// Enterprise fields are the ones accepted by the Enterprise build of the same version
+type supportedConfig struct {
+	MetricsNamespace string         `yaml:"metricsNamespace"`
+	ServeFrontend    bool           `yaml:"serveFrontend"`
+	Server           rest.Config    `yaml:"server"`
+	Kafka            kafka.Config   `yaml:"kafka"`
+	Connect          connect.Config `yaml:"connect"`
+
+	License    string              `yaml:"license"`
+	Enterprise supportedEnterprise `yaml:"enterprise"`
+	Login      supportedLogin      `yaml:"login"`
+}
+
+type supportedEnterprise struct {
+	RBAC supportedEnterpriseRBAC `yaml:"rbac"`
+}
+
+type supportedEnterpriseRBAC struct {
+	Enabled              bool   `yaml:"enabled"`
+	RoleBindingsFilepath string `yaml:"roleBindingsFilepath"`
+}
+
+type supportedLogin struct {
+	Enabled       bool                         `yaml:"enabled"`
+	JWTSecret     string                       `yaml:"jwtSecret"`
+	Google        *supportedLoginGoogle        `yaml:"google"`
+	RedpandaCloud *supportedLoginRedpandaCloud `yaml:"redpandaCloud"`
+}
+
+type supportedLoginGoogle struct {
+	Enabled      bool                           `yaml:"enabled"`
+	ClientID     string                         `yaml:"clientId"`
+	ClientSecret string                         `yaml:"clientSecret"`
+	Directory    *supportedLoginGoogleDirectory `yaml:"directory"`
+}
+
+type supportedLoginGoogleDirectory struct {
+	ServiceAccountFilepath string `yaml:"serviceAccountFilepath"`
+	TargetPrincipal        string `yaml:"targetPrincipal"`
+}
+
+type supportedLoginRedpandaCloud struct {
+	Enabled        bool   `yaml:"enabled"`
+	Domain         string `yaml:"domain"`
+	Audience       string `yaml:"audience"`
+	AllowedOrigins string `yaml:"allowedOrigins"`
+}
+
+// checkSupportedConfig returns a ConfigUnsupported ConditionError if Console would refuse to start with the config
+// Fields of the Console spec that are not supported by Console yet are rendered to keys unknown to Console
+func checkSupportedConfig(config string) error {
+	err := yaml.UnmarshalStrict([]byte(config), &supportedConfig{})
+	if err == nil {
+		return nil
+	}
+	msg := err.Error()
+	var typeErr *yaml.TypeError
+	if errors.As(err, &typeErr) {
+		msg = strings.Join(typeErr.Errors, "; ")
+	}
+	return &ConditionError{
+		Type:    redpandav1alpha1.ConfigUnsupportedConditionType,
+		Reason:  redpandav1alpha1.ConfigUnsupportedReasonUnknownKeys,
+		Message: fmt.Sprintf("Console config is not supported by Console: %s", msg),
+	}
+}
+
+// Server is the Console server config
+// Extends the upstream config with fields not supported by Console yet
+type Server struct {
+	rest.Config `yaml:",inline"`
+
+	APIBasePath string `json:"apiBasePath,omitempty" yaml:"apiBasePath,omitempty"`
+
+	MaintenanceMode    bool   `json:"maintenanceMode,omitempty" yaml:"maintenanceMode,omitempty"`
+	MaintenanceMessage string `json:"maintenanceMessage,omitempty" yaml:"maintenanceMessage,omitempty"`
+
+	UI  ServerUI   `json:"ui,omitempty" yaml:"ui,omitempty"`
+	TLS *ServerTLS `json:"tls,omitempty" yaml:"tls,omitempty"`
+
+	TrustedProxyHops int    `json:"trustedProxyHops,omitempty" yaml:"trustedProxyHops,omitempty"`
+	RequestIDHeader  string `json:"requestIdHeader,omitempty" yaml:"requestIdHeader,omitempty"`
+
+	RequestID   *ServerRequestID   `json:"requestId,omitempty" yaml:"requestId,omitempty"`
+	MetricsAuth *ServerMetricsAuth `json:"metricsAuth,omitempty" yaml:"metricsAuth,omitempty"`
+	MetricsPath string             `json:"metricsPath,omitempty" yaml:"metricsPath,omitempty"`
+	AccessLog   *ServerAccessLog   `json:"accessLog,omitempty" yaml:"accessLog,omitempty"`
+}
+
+// ServerAccessLog is the Console server access log config
+type ServerAccessLog struct {
+	SampleRate float64 `json:"sampleRate" yaml:"sampleRate"`
+}
+
+// ServerRequestID is the Console server request ID config
+type ServerRequestID struct {
+	Enabled    bool   `json:"enabled" yaml:"enabled"`
+	HeaderName string `json:"headerName,omitempty" yaml:"headerName,omitempty"`
+}
+
+// ServerMetricsAuth is the Console metrics endpoint basic auth config
+type ServerMetricsAuth struct {
+	Username string `json:"username" yaml:"username"`
+	Password string `json:"password" yaml:"password"`
+}
+
+// ServerTLS is the Console server TLS config
+type ServerTLS struct {
+	Enabled      bool                 `json:"enabled" yaml:"enabled"`
+	CertFilepath string               `json:"certFilepath" yaml:"certFilepath"`
+	KeyFilepath  string               `json:"keyFilepath" yaml:"keyFilepath"`
+	ClientAuth   *ServerTLSClientAuth `json:"clientAuth,omitempty" yaml:"clientAuth,omitempty"`
+
+	ReloadInterval time.Duration `json:"reloadInterval,omitempty" yaml:"reloadInterval,omitempty"`
+}
+
+// ServerTLSClientAuth is the Console server client certificate authentication config
+type ServerTLSClientAuth struct {
+	Mode       string `json:"mode" yaml:"mode"`
+	CaFilepath string `json:"caFilepath" yaml:"caFilepath"`
+}
+
+// ServerUI is the Console frontend config
+type ServerUI struct {
+	RefreshInterval time.Duration `json:"refreshInterval,omitempty" yaml:"refreshInterval,omitempty"`
+}
+
+// ConsoleSettings is the Console UI config
+type ConsoleSettings struct {
+	MaxMessagesPerFetch  int           `json:"maxMessagesPerFetch,omitempty" yaml:"maxMessagesPerFetch,omitempty"`
+	StatsRefreshInterval time.Duration `json:"statsRefreshInterval,omitempty" yaml:"statsRefreshInterval,omitempty"`
+
+	Branding         *ConsoleBranding `json:"branding,omitempty" yaml:"branding,omitempty"`
+	DisableTelemetry bool             `json:"disableTelemetry,omitempty" yaml:"disableTelemetry,omitempty"`
+}
+
+// ConsoleBranding is the Console UI branding config
+type ConsoleBranding struct {
+	EnvironmentLabel string `json:"environmentLabel,omitempty" yaml:"environmentLabel,omitempty"`
+}
+
+// Kafka is the Console Kafka config
+// Copying the upstream config to support fields not supported by Console yet
+type Kafka struct {
+	Brokers  []string `json:"brokers" yaml:"brokers"`
+	ClientID string   `json:"clientId" yaml:"clientId"`
+	RackID   string   `json:"rackId" yaml:"rackId"`
+
+	Schema      SchemaRegistry `json:"schemaRegistry" yaml:"schemaRegistry"`
+	Protobuf    proto.Config   `json:"protobuf" yaml:"protobuf"`
+	MessagePack msgpack.Config `json:"messagePack" yaml:"messagePack"`
+
+	TLS  KafkaTLS  `json:"tls" yaml:"tls"`
+	SASL KafkaSASL `json:"sasl" yaml:"sasl"`
+
+	Producer *KafkaProducer `json:"producer,omitempty" yaml:"producer,omitempty"`
+
+	EnableRackAwareConsumer bool `json:"enableRackAwareConsumer,omitempty" yaml:"enableRackAwareConsumer,omitempty"`
+	MaxConcurrentFetches    int  `json:"maxConcurrentFetches,omitempty" yaml:"maxConcurrentFetches,omitempty"`
+
+	RequestTimeoutOverrides map[string]time.Duration `json:"requestTimeoutOverrides,omitempty" yaml:"requestTimeoutOverrides,omitempty"`
+
+	Proxy        *KafkaProxy `json:"proxy,omitempty" yaml:"proxy,omitempty"`
+	HTTPProxyURL string      `json:"httpProxyUrl,omitempty" yaml:"httpProxyUrl,omitempty"`
+
+	MaxPollRecords             int      `json:"maxPollRecords,omitempty" yaml:"maxPollRecords,omitempty"`
+	SupportedCompressionCodecs []string `json:"supportedCompressionCodecs,omitempty" yaml:"supportedCompressionCodecs,omitempty"`
+
+	ConnectionMaxIdle time.Duration `json:"connectionMaxIdle,omitempty" yaml:"connectionMaxIdle,omitempty"`
+	BrokerTimeout     time.Duration `json:"brokerTimeout,omitempty" yaml:"brokerTimeout,omitempty"`
+
+	Retries      *int          `json:"retries,omitempty" yaml:"retries,omitempty"`
+	RetryBackoff time.Duration `json:"retryBackoff,omitempty" yaml:"retryBackoff,omitempty"`
+
+	SessionTimeout    time.Duration `json:"sessionTimeout,omitempty" yaml:"sessionTimeout,omitempty"`
+	HeartbeatInterval time.Duration `json:"heartbeatInterval,omitempty" yaml:"heartbeatInterval,omitempty"`
+}
+
+// KafkaTLS is the Console Kafka TLS config
+// Extends the upstream config with fields not supported by Console yet
+type KafkaTLS struct {
+	kafka.TLSConfig `yaml:",inline"`
+
+	Renegotiation string `json:"renegotiation,omitempty" yaml:"renegotiation,omitempty"`
+}
+
+// KafkaProxy is the config of the SOCKS5 proxy used to dial Kafka brokers
+type KafkaProxy struct {
+	SOCKS5Address string `json:"socks5Address" yaml:"socks5Address"`
+	Username      string `json:"username,omitempty" yaml:"username,omitempty"`
+	Password      string `json:"password,omitempty" yaml:"password,omitempty"`
+}
+
+// SetDefaults sets sane defaults
+func (k *Kafka) SetDefaults() {
+	k.ClientID = "redpanda-console"
+
+	k.SASL.SetDefaults()
+	k.Protobuf.SetDefaults()
+	k.MessagePack.SetDefaults()
+}
+
+// Connect is the Console Kafka Connect config
+// Copying the upstream config to support fields not supported by Console yet
+type Connect struct {
+	Enabled        bool             `json:"enabled" yaml:"enabled"`
+	Clusters       []ConnectCluster `json:"clusters" yaml:"clusters"`
+	ConnectTimeout time.Duration    `json:"connectTimeout" yaml:"connectTimeout"`
+	ReadTimeout    time.Duration    `json:"readTimeout" yaml:"readTimeout"`
+	RequestTimeout time.Duration    `json:"requestTimeout" yaml:"requestTimeout"`
+}
+
+// SchemaRegistry is the Console Schema Registry config
+// Extends the upstream config with fields not supported by Console yet
+type SchemaRegistry struct {
+	schema.Config `yaml:",inline"`
+
+	RequestTimeout time.Duration `json:"requestTimeout,omitempty" yaml:"requestTimeout,omitempty"`
+	PaginationSize int           `json:"paginationSize,omitempty" yaml:"paginationSize,omitempty"`
+}
+
+// ConnectCluster is the Console Kafka Connect cluster config
+// Extends the upstream config with fields not supported by Console yet
+type ConnectCluster struct {
+	connect.ConfigCluster `yaml:",inline"`
+
+	HiddenConnectorClasses []string `json:"hiddenConnectorClasses,omitempty" yaml:"hiddenConnectorClasses,omitempty"`
+}
+
+// KafkaSASL is the Console Kafka SASL config
+type KafkaSASL struct {
+	Enabled      bool                   `json:"enabled" yaml:"enabled"`
+	Username     string                 `json:"username" yaml:"username"`
+	Password     string                 `json:"password" yaml:"password"`
+	Mechanism    string                 `json:"mechanism" yaml:"mechanism"`
+	OAUth        KafkaSASLOAuth         `json:"oauth" yaml:"oauth"`
+	GSSAPIConfig kafka.SASLGSSAPIConfig `json:"gssapi" yaml:"gssapi"`
+	AWSMskIam    KafkaSASLAWSMskIam     `json:"awsMskIam" yaml:"awsMskIam"`
+
+	HandshakeVersion *int `json:"handshakeVersion,omitempty" yaml:"handshakeVersion,omitempty"`
+}
+
+// SetDefaults sets sane defaults
+func (s *KafkaSASL) SetDefaults() {
+	s.Mechanism = kafka.SASLMechanismPlain
+	s.GSSAPIConfig.SetDefaults()
+}
+
+// KafkaSASLAWSMskIam is the Console Kafka SASL AWS_MSK_IAM config
+// Extends the upstream config with fields not supported by Console yet
+type KafkaSASLAWSMskIam struct {
+	kafka.SASLAwsMskIam `yaml:",inline"`
+
+	Region  string `json:"region,omitempty" yaml:"region,omitempty"`
+	RoleARN string `json:"roleArn,omitempty" yaml:"roleArn,omitempty"`
+}
+
+// KafkaSASLOAuth is the Console Kafka SASL OAUTHBEARER config
+type KafkaSASLOAuth struct {
+	Token                   string        `json:"token" yaml:"token"`
+	RefreshBeforeExpiry     time.Duration `json:"refreshBeforeExpiry,omitempty" yaml:"refreshBeforeExpiry,omitempty"`
+	ReauthenticationEnabled bool          `json:"reauthenticationEnabled,omitempty" yaml:"reauthenticationEnabled,omitempty"`
+
+	TLS *KafkaSASLOAuthTLS `json:"tls,omitempty" yaml:"tls,omitempty"`
+}
+
+// KafkaSASLOAuthTLS is the Console Kafka SASL OAUTHBEARER token endpoint TLS config
+type KafkaSASLOAuthTLS struct {
+	CaFilepath string `json:"caFilepath" yaml:"caFilepath"`
+}
+
+// KafkaProducer is the config of the Kafka client used to produce records
+type KafkaProducer struct {
+	Acks string `json:"acks" yaml:"acks"`
+}
+
 // Enterprise is the Console Enterprise config
 type Enterprise struct {
-	RBAC EnterpriseRBAC `json:"rbac" yaml:"rbac"`
+	RBAC  EnterpriseRBAC      `json:"rbac" yaml:"rbac"`
+	Audit *EnterpriseAuditLog `json:"audit,omitempty" yaml:"audit,omitempty"`
+}
+
+// EnterpriseAuditLog is the Console Enterprise audit log config
+type EnterpriseAuditLog struct {
+	Enabled            bool `json:"enabled" yaml:"enabled"`
+	IncludeRequestBody bool `json:"includeRequestBody" yaml:"includeRequestBody"`
 }
 
 // EnterpriseRBAC is the Console Enterprise RBAC config
 type EnterpriseRBAC struct {
 	Enabled              bool   `json:"enabled" yaml:"enabled"`
 	RoleBindingsFilepath string `json:"roleBindingsFilepath" yaml:"roleBindingsFilepath"`
+
+	EmailDomainBindings     []EnterpriseRBACDomainBinding `json:"emailDomainBindings,omitempty" yaml:"emailDomainBindings,omitempty"`
+	AllowedRoles            []string                      `json:"allowedRoles,omitempty" yaml:"allowedRoles,omitempty"`
+	CaseInsensitiveSubjects bool                          `json:"caseInsensitiveSubjects,omitempty" yaml:"caseInsensitiveSubjects,omitempty"`
+}
+
+// EnterpriseRBACDomainBinding binds all users with email in Domain to RoleName
+type EnterpriseRBACDomainBinding struct {
+	Domain   string `json:"domain" yaml:"domain"`
+	RoleName string `json:"roleName" yaml:"roleName"`
 }
 
 // EnterpriseLogin is the Console Enterprise Login config
 type EnterpriseLogin struct {
 	Enabled       bool                                           `json:"enabled" yaml:"enabled"`
 	JWTSecret     string                                         `json:"jwtSecret,omitempty" yaml:"jwtSecret,omitempty"`
+	JWT           *EnterpriseLoginJWT                            `json:"jwt,omitempty" yaml:"jwt,omitempty"`
+	CallbackPath  string                                         `json:"callbackPath,omitempty" yaml:"callbackPath,omitempty"`
+	Session       *EnterpriseLoginSession                        `json:"session,omitempty" yaml:"session,omitempty"`
 	Google        *EnterpriseLoginGoogle                         `json:"google,omitempty" yaml:"google,omitempty"`
 	RedpandaCloud *redpandav1alpha1.EnterpriseLoginRedpandaCloud `json:"redpandaCloud,omitempty" yaml:"redpandaCloud,omitempty"`
+	OIDC          *EnterpriseLoginOIDC                           `json:"oidc,omitempty" yaml:"oidc,omitempty"`
+	GitHub        *EnterpriseLoginGitHub                         `json:"github,omitempty" yaml:"github,omitempty"`
+}
+
+// EnterpriseLoginGitHub is the Console Enterprise GitHub OAuth config
+type EnterpriseLoginGitHub struct {
+	Enabled       bool     `json:"enabled" yaml:"enabled"`
+	ClientID      string   `json:"clientId" yaml:"clientId"`
+	ClientSecret  string   `json:"clientSecret" yaml:"clientSecret"`
+	Organizations []string `json:"organizations,omitempty" yaml:"organizations,omitempty"`
+	Teams         []string `json:"teams,omitempty" yaml:"teams,omitempty"`
+}
+
+// EnterpriseLoginOIDC is the Console Enterprise generic OIDC config
+type EnterpriseLoginOIDC struct {
+	Enabled      bool     `json:"enabled" yaml:"enabled"`
+	Issuer       string   `json:"issuer" yaml:"issuer"`
+	ClientID     string   `json:"clientId" yaml:"clientId"`
+	ClientSecret string   `json:"clientSecret" yaml:"clientSecret"`
+	Scopes       []string `json:"scopes,omitempty" yaml:"scopes,omitempty"`
+	DisplayName  string   `json:"displayName,omitempty" yaml:"displayName,omitempty"`
+}
+
+// EnterpriseLoginSession is the Console Enterprise login session config
+type EnterpriseLoginSession struct {
+	Duration    time.Duration `json:"duration,omitempty" yaml:"duration,omitempty"`
+	IdleTimeout time.Duration `json:"idleTimeout,omitempty" yaml:"idleTimeout,omitempty"`
+}
+
+// EnterpriseLoginJWT is the Console Enterprise JWT config
+type EnterpriseLoginJWT struct {
+	Rotation EnterpriseLoginJWTRotation `json:"rotation" yaml:"rotation"`
+}
+
+// EnterpriseLoginJWTRotation is the Console Enterprise JWT signing secret rotation config
+type EnterpriseLoginJWTRotation struct {
+	PreviousSecret string `json:"previousSecret,omitempty" yaml:"previousSecret,omitempty"`
 }
 
 // EnterpriseLoginGoogle is the Console Enterprise Google SSO config
@@ -68,10 +427,17 @@ type EnterpriseLoginGoogle struct {
 	ClientID     string                          `json:"clientId" yaml:"clientId"`
 	ClientSecret string                          `json:"clientSecret" yaml:"clientSecret"`
 	Directory    *EnterpriseLoginGoogleDirectory `json:"directory,omitempty" yaml:"directory,omitempty"`
+
+	AdditionalScopes []string `json:"additionalScopes,omitempty" yaml:"additionalScopes,omitempty"`
+	RequireMFAClaim  string   `json:"requireMfaClaim,omitempty" yaml:"requireMfaClaim,omitempty"`
+	UsePKCE          bool     `json:"usePkce,omitempty" yaml:"usePkce,omitempty"`
+
+	ClaimMappings map[string]string `json:"claimMappings,omitempty" yaml:"claimMappings,omitempty"`
 }
 
 // EnterpriseLoginGoogleDirectory is the Console Enterprise RBAC Google groups sync config
 type EnterpriseLoginGoogleDirectory struct {
-	ServiceAccountFilepath string `json:"serviceAccountFilepath" yaml:"serviceAccountFilepath"`
-	TargetPrincipal        string `json:"targetPrincipal" yaml:"targetPrincipal"`
+	ServiceAccountFilepath string        `json:"serviceAccountFilepath" yaml:"serviceAccountFilepath"`
+	TargetPrincipal        string        `json:"targetPrincipal" yaml:"targetPrincipal"`
+	RefreshInterval        time.Duration `json:"refreshInterval,omitempty" yaml:"refreshInterval,omitempty"`
 }
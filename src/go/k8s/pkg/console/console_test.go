@@ -0,0 +1,77 @@
+// Copyright 2022 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package console_test
+
+import (
+	"time"
+
+	redpandav1alpha1 "github.com/redpanda-data/redpanda/src/go/k8s/apis/redpanda/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func testCluster() *redpandav1alpha1.Cluster {
+	return &redpandav1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cluster",
+			Namespace: "default",
+		},
+		Spec: redpandav1alpha1.ClusterSpec{
+			Configuration: redpandav1alpha1.RedpandaConfig{
+				KafkaAPI: []redpandav1alpha1.KafkaAPI{{Port: 9092}},
+			},
+		},
+		Status: redpandav1alpha1.ClusterStatus{
+			Nodes: redpandav1alpha1.NodesList{
+				Internal: []string{"cluster-0.cluster.default.svc.cluster.local"},
+			},
+		},
+	}
+}
+
+func testConsole() *redpandav1alpha1.Console {
+	duration := func(d time.Duration) *metav1.Duration {
+		return &metav1.Duration{Duration: d}
+	}
+	return &redpandav1alpha1.Console{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "console",
+			Namespace: "default",
+			UID:       "ff2770aa-c919-43f0-8b4a-30cb7cfdaf79",
+		},
+		Spec: redpandav1alpha1.ConsoleSpec{
+			MetricsPrefix: "console",
+			ServeFrontend: true,
+			Server: redpandav1alpha1.Server{
+				ServerGracefulShutdownTimeout: duration(30 * time.Second),
+				HTTPListenPort:                8080,
+				HTTPServerReadTimeout:         duration(30 * time.Second),
+				HTTPServerWriteTimeout:        duration(30 * time.Second),
+				HTTPServerIdleTimeout:         duration(30 * time.Second),
+				CompressionLevel:              4,
+			},
+			ClusterRef: redpandav1alpha1.NamespaceNameRef{Name: "cluster", Namespace: "default"},
+			Deployment: redpandav1alpha1.Deployment{
+				Image:                          "vectorized/console:latest",
+				Replicas:                       1,
+				MaxSurge:                       1,
+				ReplicasUnavailableGracePeriod: duration(5 * time.Minute),
+			},
+			Connect: redpandav1alpha1.Connect{
+				ConnectTimeout: duration(15 * time.Second),
+				ReadTimeout:    duration(60 * time.Second),
+				RequestTimeout: duration(6 * time.Second),
+			},
+		},
+		Status: redpandav1alpha1.ConsoleStatus{
+			ConfigMapRef: &corev1.ObjectReference{Name: "console-config", Namespace: "default"},
+		},
+	}
+}
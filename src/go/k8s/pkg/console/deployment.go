@@ -3,6 +3,10 @@ package console
 import (
 	"context"
 	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -16,7 +20,6 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 )
 
 // Deployment is a Console resource
@@ -72,17 +75,23 @@ func (d *Deployment) Ensure(ctx context.Context) error {
 			APIVersion: "apps/v1",
 		},
 		Spec: v1.DeploymentSpec{
-			Replicas: &d.consoleobj.Spec.Deployment.Replicas,
+			Replicas: d.getReplicas(),
 			Selector: objLabels.AsAPISelector(),
 			Template: corev1.PodTemplateSpec{
 				ObjectMeta: metav1.ObjectMeta{
-					Labels: objLabels,
+					Labels:      objLabels,
+					Annotations: d.getPodAnnotations(),
 				},
 				Spec: corev1.PodSpec{
 					Volumes:                       d.getVolumes(ss),
+					InitContainers:                d.consoleobj.Spec.Deployment.InitContainers,
 					Containers:                    d.getContainers(ss),
-					TerminationGracePeriodSeconds: getGracePeriod(d.consoleobj.Spec.Server.ServerGracefulShutdownTimeout.Duration),
-					ServiceAccountName:            sa,
+					TerminationGracePeriodSeconds: getGracePeriod(d.getTerminationGracePeriod()),
+					ServiceAccountName:            sa.GetName(),
+					ImagePullSecrets:              getImagePullSecrets(d.getPodImagePullSecrets(), sa.ImagePullSecrets),
+					HostNetwork:                   d.consoleobj.Spec.Deployment.HostNetwork,
+					DNSPolicy:                     d.getDNSPolicy(),
+					ReadinessGates:                d.consoleobj.Spec.Deployment.ReadinessGates,
 				},
 			},
 			Strategy: v1.DeploymentStrategy{
@@ -101,7 +110,8 @@ func (d *Deployment) Ensure(ctx context.Context) error {
 		},
 	}
 
-	err = controllerutil.SetControllerReference(d.consoleobj, obj, d.scheme)
+	setHelmAnnotations(d.consoleobj, obj)
+	err = setOwnerReference(d.consoleobj, obj, d.scheme)
 	if err != nil {
 		return err
 	}
@@ -111,6 +121,8 @@ func (d *Deployment) Ensure(ctx context.Context) error {
 		return fmt.Errorf("creating Console deployment: %w", err)
 	}
 
+	// Newly created Deployment has no available replicas yet
+	var status v1.DeploymentStatus
 	if !created {
 		// Update resource if not created.
 		var current v1.Deployment
@@ -118,15 +130,271 @@ func (d *Deployment) Ensure(ctx context.Context) error {
 		if err != nil {
 			return fmt.Errorf("fetching Console deployment: %w", err)
 		}
+		if d.consoleobj.IsAutoscalingEnabled() {
+			// Keep replicas set by the HorizontalPodAutoscaler
+			obj.Spec.Replicas = current.Spec.Replicas
+		}
 		_, err = resources.Update(ctx, &current, obj, d.Client, d.log)
 		if err != nil {
 			return fmt.Errorf("updating Console deployment: %w", err)
 		}
+		status = current.Status
 	}
 
+	digestChanged, err := d.setImageDigest(ctx)
+	if err != nil {
+		return err
+	}
+
+	versionChanged := d.setConsoleVersion()
+
+	if d.setReplicasCondition(status.AvailableReplicas) || digestChanged || versionChanged {
+		return UpdateStatus(ctx, d.Client, d.consoleobj)
+	}
 	return nil
 }
 
+// setImageDigest sets the image digest from the Console container status of a running pod
+// Pods running a different image than desired, e.g. during rollout, are ignored
+// Returns true if the digest changed
+func (d *Deployment) setImageDigest(ctx context.Context) (bool, error) {
+	pods := &corev1.PodList{}
+	if err := d.List(ctx, pods, client.MatchingLabels(labels.ForConsole(d.consoleobj)), client.InNamespace(d.consoleobj.GetNamespace())); err != nil {
+		return false, fmt.Errorf("listing Console pods: %w", err)
+	}
+
+	for i := range pods.Items {
+		for _, cs := range pods.Items[i].Status.ContainerStatuses {
+			if cs.Name != ConsoleContainerName || cs.State.Running == nil || normalizeImage(cs.Image) != normalizeImage(d.consoleobj.Spec.Deployment.Image) {
+				continue
+			}
+			digest := getImageDigest(cs.ImageID)
+			if digest == "" {
+				continue
+			}
+			if d.consoleobj.Status.ImageDigest == digest {
+				return false, nil
+			}
+			d.consoleobj.Status.ImageDigest = digest
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// setConsoleVersion sets the Console version from the image tag
+// Returns true if the version changed
+func (d *Deployment) setConsoleVersion() bool {
+	version := getImageTag(d.consoleobj.Spec.Deployment.Image)
+	if d.consoleobj.Status.ConsoleVersion == version {
+		return false
+	}
+	d.consoleobj.Status.ConsoleVersion = version
+	return true
+}
+
+// getImageTag returns the tag of an image reference, e.g. "v2.0.0" for "vectorized/console:v2.0.0"
+// Returns an empty string if the image has no tag
+func getImageTag(image string) string {
+	// Digest is not a tag, e.g. "vectorized/console:v2.0.0@sha256:..."
+	if i := strings.Index(image, "@"); i >= 0 {
+		image = image[:i]
+	}
+	// Colon before the last slash is the registry port, e.g. "registry:5000/console"
+	i := strings.LastIndex(image, ":")
+	if i < 0 || i < strings.LastIndex(image, "/") {
+		return ""
+	}
+	return image[i+1:]
+}
+
+// normalizeImage returns the fully qualified image reference, as reported by containerd and CRI-O
+// e.g. "vectorized/console:v2.1.1" is "docker.io/vectorized/console:v2.1.1", "busybox" is "docker.io/library/busybox:latest"
+func normalizeImage(image string) string {
+	name, suffix := image, ":latest"
+	if i := strings.Index(image, "@"); i >= 0 {
+		name, suffix = image[:i], image[i:]
+	} else if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		name, suffix = image[:i], image[i:]
+	}
+	domain := strings.SplitN(name, "/", 2)
+	switch {
+	case len(domain) == 1:
+		name = "docker.io/library/" + name
+	case !strings.ContainsAny(domain[0], ".:") && domain[0] != "localhost":
+		name = "docker.io/" + name
+	}
+	return name + suffix
+}
+
+// getImageDigest returns the digest from a container imageID, e.g. "docker-pullable://repo@sha256:..."
+func getImageDigest(imageID string) string {
+	if i := strings.LastIndex(imageID, "@"); i >= 0 {
+		return imageID[i+1:]
+	}
+	// Some runtimes report only the digest
+	if strings.HasPrefix(imageID, "sha256:") {
+		return imageID
+	}
+	return ""
+}
+
+const (
+	istioInjectAnnotation               = "sidecar.istio.io/inject"
+	istioExcludeOutboundPortsAnnotation = "traffic.sidecar.istio.io/excludeOutboundPorts"
+	linkerdInjectAnnotation             = "linkerd.io/inject"
+	linkerdSkipOutboundPortsAnnotation  = "config.linkerd.io/skip-outbound-ports"
+
+	prometheusScrapeAnnotation = "prometheus.io/scrape"
+	prometheusPathAnnotation   = "prometheus.io/path"
+	prometheusPortAnnotation   = "prometheus.io/port"
+)
+
+// DefaultMetricsPath is the Console endpoint that serves Prometheus metrics
+const DefaultMetricsPath = "/admin/metrics"
+
+// getMetricsPath returns the metrics path of Console, DefaultMetricsPath if not set
+func getMetricsPath(consoleobj *redpandav1alpha1.Console) string {
+	if m := consoleobj.Spec.Deployment.Metrics; m != nil && m.Path != "" {
+		return m.Path
+	}
+	return DefaultMetricsPath
+}
+
+// getPodAnnotations returns the annotations of the service mesh preset and the Prometheus scrape annotations
+// Kafka uses its own binary protocol, so Kafka ports bypass the mesh proxy
+func (d *Deployment) getPodAnnotations() map[string]string {
+	var annotations map[string]string
+	if d.consoleobj.Spec.Deployment.Metrics != nil {
+		annotations = map[string]string{
+			prometheusScrapeAnnotation: "true",
+			prometheusPathAnnotation:   getMetricsPath(d.consoleobj),
+			prometheusPortAnnotation:   strconv.Itoa(d.consoleobj.Spec.Server.HTTPListenPort),
+		}
+	}
+
+	mesh := d.consoleobj.Spec.Deployment.ServiceMesh
+	if mesh == nil {
+		return annotations
+	}
+
+	ports := strings.Join(d.getKafkaPorts(), ",")
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	switch mesh.Provider {
+	case redpandav1alpha1.ServiceMeshIstio:
+		annotations[istioInjectAnnotation] = "true"
+		if ports != "" {
+			annotations[istioExcludeOutboundPortsAnnotation] = ports
+		}
+	case redpandav1alpha1.ServiceMeshLinkerd:
+		annotations[linkerdInjectAnnotation] = "enabled"
+		if ports != "" {
+			annotations[linkerdSkipOutboundPortsAnnotation] = ports
+		}
+	}
+	return annotations
+}
+
+// getKafkaPorts returns the sorted Kafka ports Console connects to
+func (d *Deployment) getKafkaPorts() []string {
+	unique := map[int]bool{}
+	for _, l := range d.clusterobj.Spec.Configuration.KafkaAPI {
+		unique[l.Port] = true
+	}
+	for _, b := range d.consoleobj.Status.ResolvedBrokers {
+		if _, port, err := net.SplitHostPort(b); err == nil {
+			if p, err := strconv.Atoi(port); err == nil {
+				unique[p] = true
+			}
+		}
+	}
+
+	ports := make([]int, 0, len(unique))
+	for p := range unique {
+		ports = append(ports, p)
+	}
+	sort.Ints(ports)
+
+	out := make([]string, 0, len(ports))
+	for _, p := range ports {
+		out = append(out, strconv.Itoa(p))
+	}
+	return out
+}
+
+// getDNSPolicy returns the DNS policy of Console pods
+// Pods in the host network use the host DNS unless ClusterFirstWithHostNet is set
+func (d *Deployment) getDNSPolicy() corev1.DNSPolicy {
+	if d.consoleobj.Spec.Deployment.HostNetwork {
+		return corev1.DNSClusterFirstWithHostNet
+	}
+	return corev1.DNSClusterFirst
+}
+
+// getReplicas returns the desired replicas
+// Returns nil if replicas are managed by the HorizontalPodAutoscaler
+func (d *Deployment) getReplicas() *int32 {
+	if d.consoleobj.IsAutoscalingEnabled() {
+		return nil
+	}
+	return &d.consoleobj.Spec.Deployment.Replicas
+}
+
+// setReplicasCondition sets the MinReplicasUnavailable condition if available replicas are less than desired beyond the grace period
+// Returns true if the condition changed
+func (d *Deployment) setReplicasCondition(available int32) bool {
+	status := &d.consoleobj.Status
+	desired := d.consoleobj.Spec.Deployment.Replicas
+	if d.consoleobj.IsAutoscalingEnabled() {
+		desired = d.consoleobj.Spec.Deployment.Autoscaling.MinReplicas
+	}
+	if available >= desired {
+		return status.SetCondition(
+			redpandav1alpha1.MinReplicasUnavailableConditionType,
+			corev1.ConditionFalse,
+			redpandav1alpha1.MinReplicasUnavailableReasonAvailable,
+			"",
+		)
+	}
+
+	// LastTransitionTime of the pending condition marks when replicas became unavailable
+	cond := status.GetCondition(redpandav1alpha1.MinReplicasUnavailableConditionType)
+	if cond == nil || cond.Reason == redpandav1alpha1.MinReplicasUnavailableReasonAvailable {
+		return status.SetCondition(
+			redpandav1alpha1.MinReplicasUnavailableConditionType,
+			corev1.ConditionFalse,
+			redpandav1alpha1.MinReplicasUnavailableReasonPending,
+			"Waiting for replicas to become available",
+		)
+	}
+	if cond.Reason == redpandav1alpha1.MinReplicasUnavailableReasonPending && ReplicasUnavailableRemaining(d.consoleobj) > 0 {
+		return false
+	}
+
+	return status.SetCondition(
+		redpandav1alpha1.MinReplicasUnavailableConditionType,
+		corev1.ConditionTrue,
+		redpandav1alpha1.MinReplicasUnavailableReasonUnavailable,
+		fmt.Sprintf("%d of %d desired replicas are available", available, desired),
+	)
+}
+
+// ReplicasUnavailableRemaining returns the remaining time before the MinReplicasUnavailable condition is set
+// Returns zero if replicas are not pending to become available or the grace period has passed
+func ReplicasUnavailableRemaining(console *redpandav1alpha1.Console) time.Duration {
+	cond := console.Status.GetCondition(redpandav1alpha1.MinReplicasUnavailableConditionType)
+	if cond == nil || cond.Reason != redpandav1alpha1.MinReplicasUnavailableReasonPending {
+		return 0
+	}
+	remaining := console.GetReplicasUnavailableGracePeriod() - time.Since(cond.LastTransitionTime.Time)
+	if remaining < 0 {
+		return 0
+	}
+	return remaining
+}
+
 // Key implements Resource interface
 func (d *Deployment) Key() types.NamespacedName {
 	return types.NamespacedName{Name: d.consoleobj.GetName(), Namespace: d.consoleobj.GetNamespace()}
@@ -134,7 +402,7 @@ func (d *Deployment) Key() types.NamespacedName {
 
 // ensureServiceAccount gets or creates Service Account
 // It's best practice to use a separate Service Account per app instead of using the default
-func (d *Deployment) ensureServiceAccount(ctx context.Context) (string, error) {
+func (d *Deployment) ensureServiceAccount(ctx context.Context) (*corev1.ServiceAccount, error) {
 	sa := &corev1.ServiceAccount{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      d.consoleobj.GetName(),
@@ -147,29 +415,65 @@ func (d *Deployment) ensureServiceAccount(ctx context.Context) (string, error) {
 		},
 	}
 
-	err := controllerutil.SetControllerReference(d.consoleobj, sa, d.scheme)
+	setHelmAnnotations(d.consoleobj, sa)
+	err := setOwnerReference(d.consoleobj, sa, d.scheme)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
 	created, err := resources.CreateIfNotExists(ctx, d.Client, sa, d.log)
 	if err != nil {
-		return "", fmt.Errorf("creating Console serviceaccount: %w", err)
+		return nil, fmt.Errorf("creating Console serviceaccount: %w", err)
 	}
 
 	if !created {
 		var currentSA corev1.ServiceAccount
 		err = d.Get(ctx, types.NamespacedName{Name: sa.GetName(), Namespace: sa.GetNamespace()}, &currentSA)
 		if err != nil {
-			return "", fmt.Errorf("fetching Console serviceaccount: %w", err)
+			return nil, fmt.Errorf("fetching Console serviceaccount: %w", err)
 		}
+		// Keep pull secrets added to the ServiceAccount, e.g. by registry credential controllers
+		sa.ImagePullSecrets = currentSA.ImagePullSecrets
 		_, err = resources.Update(ctx, &currentSA, sa, d.Client, d.log)
 		if err != nil {
-			return "", fmt.Errorf("updating Console serviceaccount: %w", err)
+			return nil, fmt.Errorf("updating Console serviceaccount: %w", err)
 		}
 	}
 
-	return sa.GetName(), nil
+	return sa, nil
+}
+
+// DefaultImagePullSecret is the pull secret added to the pods of all Consoles, e.g. of a private registry mirror
+// The Secret must exist in the Console namespace, if empty no pull secret is added
+var DefaultImagePullSecret string
+
+// getPodImagePullSecrets returns the pull secrets of the Console with DefaultImagePullSecret
+func (d *Deployment) getPodImagePullSecrets() []corev1.LocalObjectReference {
+	secrets := d.consoleobj.Spec.Deployment.ImagePullSecrets
+	if DefaultImagePullSecret == "" {
+		return secrets
+	}
+	return append(append([]corev1.LocalObjectReference{}, secrets...), corev1.LocalObjectReference{Name: DefaultImagePullSecret})
+}
+
+// getImagePullSecrets returns the pod pull secrets merged with the pull secrets of the ServiceAccount
+// Pull secrets of the ServiceAccount are only injected by Kubernetes if the pod has none, so these are merged explicitly
+func getImagePullSecrets(pod, sa []corev1.LocalObjectReference) []corev1.LocalObjectReference {
+	if len(pod) == 0 {
+		return nil
+	}
+	seen := map[string]bool{}
+	secrets := make([]corev1.LocalObjectReference, 0, len(pod)+len(sa))
+	for _, list := range [][]corev1.LocalObjectReference{pod, sa} {
+		for _, s := range list {
+			if seen[s.Name] {
+				continue
+			}
+			seen[s.Name] = true
+			secrets = append(secrets, s)
+		}
+	}
+	return secrets
 }
 
 // ensureSyncedSecrets ensures that Secrets required by Deployment are available
@@ -224,7 +528,8 @@ func (d *Deployment) ensureSyncedSecrets(ctx context.Context) (string, error) {
 		Data: data,
 	}
 
-	err := controllerutil.SetControllerReference(d.consoleobj, secret, d.scheme)
+	setHelmAnnotations(d.consoleobj, secret)
+	err := setOwnerReference(d.consoleobj, secret, d.scheme)
 	if err != nil {
 		return "", err
 	}
@@ -249,6 +554,30 @@ func (d *Deployment) ensureSyncedSecrets(ctx context.Context) (string, error) {
 	return secret.GetName(), nil
 }
 
+// getTerminationGracePeriod returns the time to drain Console and shut down the server gracefully
+func (d *Deployment) getTerminationGracePeriod() time.Duration {
+	period := d.consoleobj.Spec.Server.ServerGracefulShutdownTimeout.Duration
+	if drain := d.consoleobj.Spec.Deployment.DrainTimeout; drain != nil {
+		period += drain.Duration
+	}
+	return period
+}
+
+// getLifecycle returns the preStop hook that waits for DrainTimeout before Console receives SIGTERM
+func (d *Deployment) getLifecycle() *corev1.Lifecycle {
+	drain := d.consoleobj.Spec.Deployment.DrainTimeout
+	if drain == nil || drain.Duration <= 0 {
+		return nil
+	}
+	return &corev1.Lifecycle{
+		PreStop: &corev1.Handler{
+			Exec: &corev1.ExecAction{
+				Command: []string{"sleep", strconv.FormatInt(*getGracePeriod(drain.Duration), 10)},
+			},
+		},
+	}
+}
+
 func getGracePeriod(period time.Duration) *int64 {
 	gracePeriod := period.Nanoseconds() / time.Second.Nanoseconds()
 	return &gracePeriod
@@ -267,19 +596,44 @@ const (
 	enterpriseRBACMountPath     = "/etc/console/enterprise/rbac"
 	enterpriseGoogleSAMountName = "enterprise-google-sa"
 	enterpriseGoogleSAMountPath = "/etc/console/enterprise/google"
+
+	protobufMountName = "protobuf"
+	protobufMountPath = "/etc/console/protobuf"
+
+	tlsServerMountName   = "tls-server"
+	tlsClientCAMountName = "tls-client-ca"
+	tlsOAuthCAMountName  = "tls-oauth-ca"
+	tlsKafkaCAMountName  = "tls-kafka-ca"
+
+	krb5ConfigMountName = "krb5-conf"
+	krb5KeytabMountName = "krb5-keytab"
+
+	tmpMountName   = "tmp"
+	tmpMountPath   = "/tmp"
+	cacheMountName = "cache"
+	cacheMountPath = "/var/cache/console"
 )
 
 func (d *Deployment) getVolumes(ss string) []corev1.Volume {
+	ref := d.consoleobj.Status.ConfigMapRef
+	config := corev1.VolumeSource{
+		ConfigMap: &corev1.ConfigMapVolumeSource{
+			LocalObjectReference: corev1.LocalObjectReference{
+				Name: ref.Name,
+			},
+		},
+	}
+	if getConfigKind(ref) == configKindSecret {
+		config = corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{
+				SecretName: ref.Name,
+			},
+		}
+	}
 	volumes := []corev1.Volume{
 		{
-			Name: configMountName,
-			VolumeSource: corev1.VolumeSource{
-				ConfigMap: &corev1.ConfigMapVolumeSource{
-					LocalObjectReference: corev1.LocalObjectReference{
-						Name: d.consoleobj.Status.ConfigMapRef.Name,
-					},
-				},
-			},
+			Name:         configMountName,
+			VolumeSource: config,
 		},
 	}
 
@@ -320,6 +674,17 @@ func (d *Deployment) getVolumes(ss string) []corev1.Volume {
 		})
 	}
 
+	if protobuf := d.consoleobj.Spec.Kafka.Protobuf; protobuf != nil {
+		volumes = append(volumes, corev1.Volume{
+			Name: protobufMountName,
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: protobuf.DescriptorConfigMapRef,
+				},
+			},
+		})
+	}
+
 	if login := d.consoleobj.Spec.Login; login != nil && login.Google != nil && login.Google.Directory != nil {
 		volumes = append(volumes, corev1.Volume{
 			Name: enterpriseGoogleSAMountName,
@@ -331,6 +696,87 @@ func (d *Deployment) getVolumes(ss string) []corev1.Volume {
 		})
 	}
 
+	if d.consoleobj.IsServerTLSEnabled() {
+		volumes = append(volumes, corev1.Volume{
+			Name: tlsServerMountName,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: d.consoleobj.Spec.Server.TLS.SecretRef.Name,
+				},
+			},
+		})
+	}
+
+	if d.consoleobj.IsServerTLSClientAuthEnabled() {
+		volumes = append(volumes, corev1.Volume{
+			Name: tlsClientCAMountName,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: d.consoleobj.Spec.Server.TLS.ClientAuth.CARef.Name,
+				},
+			},
+		})
+	}
+
+	if d.consoleobj.IsKafkaSASLOAuthTLSEnabled() {
+		volumes = append(volumes, corev1.Volume{
+			Name: tlsOAuthCAMountName,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: d.consoleobj.Spec.Kafka.SASL.OAuth.TLS.CARef.Name,
+				},
+			},
+		})
+	}
+
+	if key, ok := kafkaTLSCAKey(d.consoleobj, d.clusterobj); ok {
+		volumes = append(volumes, corev1.Volume{
+			Name: tlsKafkaCAMountName,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: key.Name,
+					// The Cluster node certificate Secret also contains the private key
+					Items: []corev1.KeyToPath{{Key: "ca.crt", Path: "ca.crt"}},
+				},
+			},
+		})
+	}
+
+	if d.consoleobj.IsKafkaSASLKerberosEnabled() {
+		volumes = append(volumes, corev1.Volume{
+			Name: krb5ConfigMountName,
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: d.consoleobj.Spec.Kafka.SASL.Kerberos.Krb5ConfigMapRef,
+					Items:                []corev1.KeyToPath{{Key: KafkaSASLKerberosConfigKey, Path: KafkaSASLKerberosConfigKey}},
+				},
+			},
+		})
+	}
+
+	if d.consoleobj.IsKafkaSASLKerberosKeytabEnabled() {
+		volumes = append(volumes, corev1.Volume{
+			Name: krb5KeytabMountName,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: d.consoleobj.Spec.Kafka.SASL.Kerberos.KeytabSecretRef.Name,
+					Items:      []corev1.KeyToPath{{Key: KafkaSASLKerberosKeytabKey, Path: KafkaSASLKerberosKeytabKey}},
+				},
+			},
+		})
+	}
+
+	if d.consoleobj.IsReadOnlyRootFilesystem() {
+		for _, name := range []string{tmpMountName, cacheMountName} {
+			volumes = append(volumes, corev1.Volume{
+				Name: name,
+				VolumeSource: corev1.VolumeSource{
+					EmptyDir: &corev1.EmptyDirVolumeSource{},
+				},
+			})
+		}
+	}
+
 	return volumes
 }
 
@@ -373,6 +819,14 @@ func (d *Deployment) getContainers(ss string) []corev1.Container {
 		})
 	}
 
+	if d.consoleobj.Spec.Kafka.Protobuf != nil {
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{
+			Name:      protobufMountName,
+			ReadOnly:  true,
+			MountPath: protobufMountPath,
+		})
+	}
+
 	if login := d.consoleobj.Spec.Login; login != nil && login.Google != nil && login.Google.Directory != nil {
 		volumeMounts = append(volumeMounts, corev1.VolumeMount{
 			Name:      enterpriseGoogleSAMountName,
@@ -381,6 +835,66 @@ func (d *Deployment) getContainers(ss string) []corev1.Container {
 		})
 	}
 
+	if d.consoleobj.IsServerTLSEnabled() {
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{
+			Name:      tlsServerMountName,
+			ReadOnly:  true,
+			MountPath: ServerTLSDir,
+		})
+	}
+
+	if d.consoleobj.IsServerTLSClientAuthEnabled() {
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{
+			Name:      tlsClientCAMountName,
+			ReadOnly:  true,
+			MountPath: ServerTLSClientCADir,
+		})
+	}
+
+	if d.consoleobj.IsKafkaSASLOAuthTLSEnabled() {
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{
+			Name:      tlsOAuthCAMountName,
+			ReadOnly:  true,
+			MountPath: KafkaSASLOAuthTLSDir,
+		})
+	}
+
+	if _, ok := kafkaTLSCAKey(d.consoleobj, d.clusterobj); ok {
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{
+			Name:      tlsKafkaCAMountName,
+			ReadOnly:  true,
+			MountPath: KafkaTLSDir,
+		})
+	}
+
+	if d.consoleobj.IsKafkaSASLKerberosEnabled() {
+		// Mount the file only, /etc must not be shadowed
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{
+			Name:      krb5ConfigMountName,
+			ReadOnly:  true,
+			MountPath: KafkaSASLKerberosConfigPath,
+			SubPath:   KafkaSASLKerberosConfigKey,
+		})
+	}
+
+	if d.consoleobj.IsKafkaSASLKerberosKeytabEnabled() {
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{
+			Name:      krb5KeytabMountName,
+			ReadOnly:  true,
+			MountPath: KafkaSASLKerberosKeytabDir,
+		})
+	}
+
+	var env []corev1.EnvVar
+	if d.consoleobj.IsReadOnlyRootFilesystem() {
+		volumeMounts = append(volumeMounts,
+			corev1.VolumeMount{Name: tmpMountName, MountPath: tmpMountPath},
+			corev1.VolumeMount{Name: cacheMountName, MountPath: cacheMountPath},
+		)
+		// Point the user cache dir to the writable volume
+		env = append(env, corev1.EnvVar{Name: "XDG_CACHE_HOME", Value: cacheMountPath})
+	}
+
 	return []corev1.Container{
 		{
 			Name:  ConsoleContainerName,
@@ -393,7 +907,38 @@ func (d *Deployment) getContainers(ss string) []corev1.Container {
 					Protocol:      "TCP",
 				},
 			},
-			VolumeMounts: volumeMounts,
+			Env:             env,
+			VolumeMounts:    volumeMounts,
+			SecurityContext: d.consoleobj.Spec.Deployment.SecurityContext,
+			ReadinessProbe:  d.getReadinessProbe(),
+			Lifecycle:       d.getLifecycle(),
+
+			TerminationMessagePolicy: d.consoleobj.Spec.Deployment.TerminationMessagePolicy,
+		},
+	}
+}
+
+// KafkaReadinessPath is the Console endpoint that is ready once Console is connected to Kafka
+const KafkaReadinessPath = "/admin/ready"
+
+// getReadinessProbe returns the Kafka-aware readiness probe if enabled
+func (d *Deployment) getReadinessProbe() *corev1.Probe {
+	if !d.consoleobj.Spec.Server.KafkaAwareReadiness {
+		return nil
+	}
+	scheme := corev1.URISchemeHTTP
+	if d.consoleobj.IsServerTLSEnabled() {
+		scheme = corev1.URISchemeHTTPS
+	}
+	return &corev1.Probe{
+		Handler: corev1.Handler{
+			HTTPGet: &corev1.HTTPGetAction{
+				Path:   KafkaReadinessPath,
+				Port:   intstr.FromString("http"),
+				Scheme: scheme,
+			},
 		},
+		PeriodSeconds:    10,
+		FailureThreshold: 3,
 	}
 }
@@ -0,0 +1,663 @@
+// Copyright 2022 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package console_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	redpandav1alpha1 "github.com/redpanda-data/redpanda/src/go/k8s/apis/redpanda/v1alpha1"
+	"github.com/redpanda-data/redpanda/src/go/k8s/pkg/console"
+	"github.com/redpanda-data/redpanda/src/go/k8s/pkg/labels"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestEnsureDeployment_MinReplicasUnavailable(t *testing.T) {
+	require.NoError(t, redpandav1alpha1.AddToScheme(scheme.Scheme))
+	ctx := context.Background()
+
+	consoleobj := testConsole()
+	consoleobj.Spec.Deployment.Replicas = 3
+	consoleobj.Spec.Deployment.ReplicasUnavailableGracePeriod = &metav1.Duration{Duration: time.Hour}
+	cluster := testCluster()
+
+	c := fake.NewClientBuilder().Build()
+	require.NoError(t, c.Create(ctx, consoleobj))
+	require.NoError(t, c.Create(ctx, &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      consoleobj.GetName(),
+			Namespace: consoleobj.GetNamespace(),
+		},
+		Status: appsv1.DeploymentStatus{
+			Replicas:          3,
+			AvailableReplicas: 1,
+		},
+	}))
+
+	ensure := func() *redpandav1alpha1.ConsoleCondition {
+		d := console.NewDeployment(c, scheme.Scheme, consoleobj, cluster, console.NewStore(c), ctrl.Log.WithName("test"))
+		require.NoError(t, d.Ensure(ctx))
+		actual := &redpandav1alpha1.Console{}
+		require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(consoleobj), actual))
+		cond := actual.Status.GetCondition(redpandav1alpha1.MinReplicasUnavailableConditionType)
+		require.NotNil(t, cond)
+		return cond
+	}
+
+	// Within the grace window the condition is not yet raised
+	cond := ensure()
+	assert.Equal(t, corev1.ConditionFalse, cond.Status)
+	assert.Equal(t, redpandav1alpha1.MinReplicasUnavailableReasonPending, cond.Reason)
+	assert.Greater(t, console.ReplicasUnavailableRemaining(consoleobj), time.Duration(0))
+
+	cond = ensure()
+	assert.Equal(t, corev1.ConditionFalse, cond.Status)
+
+	// Grace period falls back to the default if not set
+	consoleobj.Spec.Deployment.ReplicasUnavailableGracePeriod = nil
+	cond = ensure()
+	assert.Equal(t, corev1.ConditionFalse, cond.Status)
+	assert.Greater(t, console.ReplicasUnavailableRemaining(consoleobj), time.Duration(0))
+	assert.LessOrEqual(t, console.ReplicasUnavailableRemaining(consoleobj), redpandav1alpha1.DefaultReplicasUnavailableGracePeriod)
+
+	// After the grace window the condition is raised
+	consoleobj.Spec.Deployment.ReplicasUnavailableGracePeriod = &metav1.Duration{Duration: 0}
+	cond = ensure()
+	assert.Equal(t, corev1.ConditionTrue, cond.Status)
+	assert.Equal(t, redpandav1alpha1.MinReplicasUnavailableReasonUnavailable, cond.Reason)
+	assert.Equal(t, time.Duration(0), console.ReplicasUnavailableRemaining(consoleobj))
+}
+
+func TestEnsureDeployment_ServiceMeshIstio(t *testing.T) {
+	require.NoError(t, redpandav1alpha1.AddToScheme(scheme.Scheme))
+	ctx := context.Background()
+
+	consoleobj := testConsole()
+	consoleobj.Spec.Deployment.ServiceMesh = &redpandav1alpha1.ServiceMesh{Provider: redpandav1alpha1.ServiceMeshIstio}
+	cluster := testCluster()
+	cluster.Spec.Configuration.KafkaAPI = append(cluster.Spec.Configuration.KafkaAPI, redpandav1alpha1.KafkaAPI{Port: 30092})
+
+	c := fake.NewClientBuilder().Build()
+	require.NoError(t, c.Create(ctx, consoleobj))
+	d := console.NewDeployment(c, scheme.Scheme, consoleobj, cluster, console.NewStore(c), ctrl.Log.WithName("test"))
+	require.NoError(t, d.Ensure(ctx))
+
+	actual := &appsv1.Deployment{}
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(consoleobj), actual))
+	assert.Equal(t, map[string]string{
+		"sidecar.istio.io/inject":                       "true",
+		"traffic.sidecar.istio.io/excludeOutboundPorts": "9092,30092",
+	}, actual.Spec.Template.Annotations)
+}
+
+func TestEnsureDeployment_ReadOnlyRootFilesystem(t *testing.T) {
+	require.NoError(t, redpandav1alpha1.AddToScheme(scheme.Scheme))
+	ctx := context.Background()
+
+	readOnly := true
+	consoleobj := testConsole()
+	consoleobj.Spec.Deployment.SecurityContext = &corev1.SecurityContext{ReadOnlyRootFilesystem: &readOnly}
+	cluster := testCluster()
+
+	c := fake.NewClientBuilder().Build()
+	require.NoError(t, c.Create(ctx, consoleobj))
+	d := console.NewDeployment(c, scheme.Scheme, consoleobj, cluster, console.NewStore(c), ctrl.Log.WithName("test"))
+	require.NoError(t, d.Ensure(ctx))
+
+	actual := &appsv1.Deployment{}
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(consoleobj), actual))
+
+	emptyDirs := map[string]bool{}
+	for _, v := range actual.Spec.Template.Spec.Volumes {
+		if v.EmptyDir != nil {
+			emptyDirs[v.Name] = true
+		}
+	}
+	assert.Equal(t, map[string]bool{"tmp": true, "cache": true}, emptyDirs)
+
+	container := actual.Spec.Template.Spec.Containers[0]
+	require.NotNil(t, container.SecurityContext)
+	assert.True(t, *container.SecurityContext.ReadOnlyRootFilesystem)
+	mounts := map[string]string{}
+	for _, m := range container.VolumeMounts {
+		mounts[m.Name] = m.MountPath
+	}
+	assert.Equal(t, "/tmp", mounts["tmp"])
+	assert.Equal(t, "/var/cache/console", mounts["cache"])
+}
+
+func TestEnsureDeployment_ImageDigest(t *testing.T) {
+	require.NoError(t, redpandav1alpha1.AddToScheme(scheme.Scheme))
+	ctx := context.Background()
+
+	consoleobj := testConsole()
+	cluster := testCluster()
+
+	c := fake.NewClientBuilder().Build()
+	require.NoError(t, c.Create(ctx, consoleobj))
+	require.NoError(t, c.Create(ctx, &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      consoleobj.GetName() + "-abc",
+			Namespace: consoleobj.GetNamespace(),
+			Labels:    labels.ForConsole(consoleobj),
+		},
+		Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{{
+				Name:    console.ConsoleContainerName,
+				Image:   consoleobj.Spec.Deployment.Image,
+				ImageID: "docker-pullable://vectorized/console@sha256:0123456789abcdef",
+				State:   corev1.ContainerState{Running: &corev1.ContainerStateRunning{}},
+			}},
+		},
+	}))
+
+	d := console.NewDeployment(c, scheme.Scheme, consoleobj, cluster, console.NewStore(c), ctrl.Log.WithName("test"))
+	require.NoError(t, d.Ensure(ctx))
+
+	actual := &redpandav1alpha1.Console{}
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(consoleobj), actual))
+	assert.Equal(t, "sha256:0123456789abcdef", actual.Status.ImageDigest)
+}
+
+func TestEnsureDeployment_ImageDigestNormalizedImage(t *testing.T) {
+	require.NoError(t, redpandav1alpha1.AddToScheme(scheme.Scheme))
+	ctx := context.Background()
+
+	consoleobj := testConsole()
+	consoleobj.Spec.Deployment.Image = "vectorized/console:v2.1.1"
+	cluster := testCluster()
+
+	c := fake.NewClientBuilder().Build()
+	require.NoError(t, c.Create(ctx, consoleobj))
+	// containerd and CRI-O report the fully qualified image
+	require.NoError(t, c.Create(ctx, &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      consoleobj.GetName() + "-abc",
+			Namespace: consoleobj.GetNamespace(),
+			Labels:    labels.ForConsole(consoleobj),
+		},
+		Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{{
+				Name:    console.ConsoleContainerName,
+				Image:   "docker.io/vectorized/console:v2.1.1",
+				ImageID: "docker.io/vectorized/console@sha256:0123456789abcdef",
+				State:   corev1.ContainerState{Running: &corev1.ContainerStateRunning{}},
+			}},
+		},
+	}))
+	// Pod still running the previous image during rollout is ignored
+	require.NoError(t, c.Create(ctx, &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      consoleobj.GetName() + "-def",
+			Namespace: consoleobj.GetNamespace(),
+			Labels:    labels.ForConsole(consoleobj),
+		},
+		Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{{
+				Name:    console.ConsoleContainerName,
+				Image:   "docker.io/vectorized/console:v2.1.0",
+				ImageID: "docker.io/vectorized/console@sha256:fedcba9876543210",
+				State:   corev1.ContainerState{Running: &corev1.ContainerStateRunning{}},
+			}},
+		},
+	}))
+
+	d := console.NewDeployment(c, scheme.Scheme, consoleobj, cluster, console.NewStore(c), ctrl.Log.WithName("test"))
+	require.NoError(t, d.Ensure(ctx))
+
+	actual := &redpandav1alpha1.Console{}
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(consoleobj), actual))
+	assert.Equal(t, "sha256:0123456789abcdef", actual.Status.ImageDigest)
+}
+
+func TestEnsureDeployment_HostNetwork(t *testing.T) {
+	require.NoError(t, redpandav1alpha1.AddToScheme(scheme.Scheme))
+	ctx := context.Background()
+
+	consoleobj := testConsole()
+	cluster := testCluster()
+
+	c := fake.NewClientBuilder().Build()
+	require.NoError(t, c.Create(ctx, consoleobj))
+
+	ensure := func() corev1.PodSpec {
+		d := console.NewDeployment(c, scheme.Scheme, consoleobj, cluster, console.NewStore(c), ctrl.Log.WithName("test"))
+		require.NoError(t, d.Ensure(ctx))
+		actual := &appsv1.Deployment{}
+		require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(consoleobj), actual))
+		return actual.Spec.Template.Spec
+	}
+
+	spec := ensure()
+	assert.False(t, spec.HostNetwork)
+	assert.Equal(t, corev1.DNSClusterFirst, spec.DNSPolicy)
+
+	consoleobj.Spec.Deployment.HostNetwork = true
+	spec = ensure()
+	assert.True(t, spec.HostNetwork)
+	assert.Equal(t, corev1.DNSClusterFirstWithHostNet, spec.DNSPolicy)
+}
+
+func TestEnsureDeployment_ServerTLSClientAuth(t *testing.T) {
+	require.NoError(t, redpandav1alpha1.AddToScheme(scheme.Scheme))
+	ctx := context.Background()
+
+	consoleobj := testConsole()
+	consoleobj.Spec.Server.TLS = &redpandav1alpha1.ServerTLS{
+		Enabled:   true,
+		SecretRef: corev1.LocalObjectReference{Name: "console-tls"},
+		ClientAuth: &redpandav1alpha1.ServerTLSClientAuth{
+			Mode:  redpandav1alpha1.ServerTLSClientAuthRequireAndVerify,
+			CARef: corev1.LocalObjectReference{Name: "client-ca"},
+		},
+	}
+	cluster := testCluster()
+
+	c := fake.NewClientBuilder().Build()
+	require.NoError(t, c.Create(ctx, consoleobj))
+
+	ensureConfigUnsupported(t, c, consoleobj, cluster, "tls")
+
+	// The Deployment keeps the previous config
+	consoleobj.Status.ConfigMapRef = &corev1.ObjectReference{Kind: "ConfigMap", Namespace: "default", Name: "console-previous"}
+	d := console.NewDeployment(c, scheme.Scheme, consoleobj, cluster, console.NewStore(c), ctrl.Log.WithName("test"))
+	require.NoError(t, d.Ensure(ctx))
+	actual := &appsv1.Deployment{}
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(consoleobj), actual))
+
+	secrets := map[string]string{}
+	for _, v := range actual.Spec.Template.Spec.Volumes {
+		if v.Secret != nil {
+			secrets[v.Name] = v.Secret.SecretName
+		}
+	}
+	assert.Equal(t, "console-tls", secrets["tls-server"])
+	assert.Equal(t, "client-ca", secrets["tls-client-ca"])
+
+	mounts := map[string]string{}
+	for _, m := range actual.Spec.Template.Spec.Containers[0].VolumeMounts {
+		mounts[m.Name] = m.MountPath
+	}
+	assert.Equal(t, "/etc/console/tls/server", mounts["tls-server"])
+	assert.Equal(t, "/etc/console/tls/client-ca", mounts["tls-client-ca"])
+}
+
+func TestEnsureDeployment_ProtobufDescriptorConfigMap(t *testing.T) {
+	require.NoError(t, redpandav1alpha1.AddToScheme(scheme.Scheme))
+	ctx := context.Background()
+
+	consoleobj := testConsole()
+	consoleobj.Spec.Kafka.Protobuf = &redpandav1alpha1.KafkaProtobuf{
+		DescriptorConfigMapRef: corev1.LocalObjectReference{Name: "proto-descriptors"},
+		Mappings: []redpandav1alpha1.KafkaProtobufTopicMapping{
+			{TopicName: "orders", ValueProtoType: "shop.v1.Order"},
+		},
+	}
+	cluster := testCluster()
+
+	c := fake.NewClientBuilder().Build()
+	require.NoError(t, c.Create(ctx, consoleobj))
+
+	cc := ensureConfig(t, c, consoleobj, cluster)
+	assert.True(t, cc.Kafka.Protobuf.Enabled)
+	assert.True(t, cc.Kafka.Protobuf.FileSystem.Enabled)
+	assert.Equal(t, []string{"/etc/console/protobuf"}, cc.Kafka.Protobuf.FileSystem.Paths)
+	require.Len(t, cc.Kafka.Protobuf.Mappings, 1)
+	assert.Equal(t, "shop.v1.Order", cc.Kafka.Protobuf.Mappings[0].ValueProtoType)
+
+	d := console.NewDeployment(c, scheme.Scheme, consoleobj, cluster, console.NewStore(c), ctrl.Log.WithName("test"))
+	require.NoError(t, d.Ensure(ctx))
+	actual := &appsv1.Deployment{}
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(consoleobj), actual))
+
+	configMaps := map[string]string{}
+	for _, v := range actual.Spec.Template.Spec.Volumes {
+		if v.ConfigMap != nil {
+			configMaps[v.Name] = v.ConfigMap.Name
+		}
+	}
+	assert.Equal(t, "proto-descriptors", configMaps["protobuf"])
+
+	mounts := map[string]string{}
+	for _, m := range actual.Spec.Template.Spec.Containers[0].VolumeMounts {
+		mounts[m.Name] = m.MountPath
+	}
+	assert.Equal(t, "/etc/console/protobuf", mounts["protobuf"])
+}
+
+func TestEnsureDeployment_ConsoleVersion(t *testing.T) {
+	require.NoError(t, redpandav1alpha1.AddToScheme(scheme.Scheme))
+	ctx := context.Background()
+
+	consoleobj := testConsole()
+	consoleobj.Spec.Deployment.Image = "registry.example.com:5000/redpandadata/console:v2.1.0"
+	cluster := testCluster()
+
+	c := fake.NewClientBuilder().Build()
+	require.NoError(t, c.Create(ctx, consoleobj))
+	d := console.NewDeployment(c, scheme.Scheme, consoleobj, cluster, console.NewStore(c), ctrl.Log.WithName("test"))
+	require.NoError(t, d.Ensure(ctx))
+
+	actual := &redpandav1alpha1.Console{}
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(consoleobj), actual))
+	assert.Equal(t, "v2.1.0", actual.Status.ConsoleVersion)
+}
+
+func TestEnsureDeployment_KafkaAwareReadiness(t *testing.T) {
+	require.NoError(t, redpandav1alpha1.AddToScheme(scheme.Scheme))
+	ctx := context.Background()
+
+	consoleobj := testConsole()
+	consoleobj.Spec.Server.KafkaAwareReadiness = true
+
+	c := fake.NewClientBuilder().Build()
+	require.NoError(t, c.Create(ctx, consoleobj))
+	d := console.NewDeployment(c, scheme.Scheme, consoleobj, testCluster(), console.NewStore(c), ctrl.Log.WithName("test"))
+	require.NoError(t, d.Ensure(ctx))
+
+	actual := &appsv1.Deployment{}
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(consoleobj), actual))
+	probe := actual.Spec.Template.Spec.Containers[0].ReadinessProbe
+	require.NotNil(t, probe)
+	require.NotNil(t, probe.HTTPGet)
+	assert.Equal(t, "/admin/ready", probe.HTTPGet.Path)
+	assert.Equal(t, "http", probe.HTTPGet.Port.String())
+	assert.Equal(t, corev1.URISchemeHTTP, probe.HTTPGet.Scheme)
+}
+
+func TestEnsureDeployment_TerminationMessagePolicy(t *testing.T) {
+	require.NoError(t, redpandav1alpha1.AddToScheme(scheme.Scheme))
+	ctx := context.Background()
+
+	consoleobj := testConsole()
+	consoleobj.Spec.Deployment.TerminationMessagePolicy = corev1.TerminationMessageFallbackToLogsOnError
+
+	c := fake.NewClientBuilder().Build()
+	require.NoError(t, c.Create(ctx, consoleobj))
+	d := console.NewDeployment(c, scheme.Scheme, consoleobj, testCluster(), console.NewStore(c), ctrl.Log.WithName("test"))
+	require.NoError(t, d.Ensure(ctx))
+
+	actual := &appsv1.Deployment{}
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(consoleobj), actual))
+	assert.Equal(t, corev1.TerminationMessageFallbackToLogsOnError, actual.Spec.Template.Spec.Containers[0].TerminationMessagePolicy)
+}
+
+func TestEnsureDeployment_DrainTimeout(t *testing.T) {
+	require.NoError(t, redpandav1alpha1.AddToScheme(scheme.Scheme))
+	ctx := context.Background()
+
+	consoleobj := testConsole()
+	consoleobj.Spec.Deployment.DrainTimeout = &metav1.Duration{Duration: 15 * time.Second}
+
+	c := fake.NewClientBuilder().Build()
+	require.NoError(t, c.Create(ctx, consoleobj))
+	d := console.NewDeployment(c, scheme.Scheme, consoleobj, testCluster(), console.NewStore(c), ctrl.Log.WithName("test"))
+	require.NoError(t, d.Ensure(ctx))
+
+	actual := &appsv1.Deployment{}
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(consoleobj), actual))
+	spec := actual.Spec.Template.Spec
+	// Drain timeout is added to the graceful shutdown timeout of 30s
+	require.NotNil(t, spec.TerminationGracePeriodSeconds)
+	assert.Equal(t, int64(45), *spec.TerminationGracePeriodSeconds)
+	lifecycle := spec.Containers[0].Lifecycle
+	require.NotNil(t, lifecycle)
+	require.NotNil(t, lifecycle.PreStop)
+	require.NotNil(t, lifecycle.PreStop.Exec)
+	assert.Equal(t, []string{"sleep", "15"}, lifecycle.PreStop.Exec.Command)
+}
+
+func TestEnsureDeployment_InitContainers(t *testing.T) {
+	require.NoError(t, redpandav1alpha1.AddToScheme(scheme.Scheme))
+	ctx := context.Background()
+
+	consoleobj := testConsole()
+	consoleobj.Spec.Deployment.InitContainers = []corev1.Container{
+		{Name: "wait-for-vault", Image: "busybox", Command: []string{"sh", "-c", "until nc -z vault 8200; do sleep 1; done"}},
+		{Name: "fetch-certs", Image: "busybox"},
+	}
+
+	c := fake.NewClientBuilder().Build()
+	require.NoError(t, c.Create(ctx, consoleobj))
+	d := console.NewDeployment(c, scheme.Scheme, consoleobj, testCluster(), console.NewStore(c), ctrl.Log.WithName("test"))
+	require.NoError(t, d.Ensure(ctx))
+
+	actual := &appsv1.Deployment{}
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(consoleobj), actual))
+	initContainers := actual.Spec.Template.Spec.InitContainers
+	require.Len(t, initContainers, 2)
+	assert.Equal(t, "wait-for-vault", initContainers[0].Name)
+	assert.Equal(t, "fetch-certs", initContainers[1].Name)
+}
+
+func TestEnsureDeployment_ReadinessGates(t *testing.T) {
+	require.NoError(t, redpandav1alpha1.AddToScheme(scheme.Scheme))
+	ctx := context.Background()
+
+	consoleobj := testConsole()
+	consoleobj.Spec.Deployment.ReadinessGates = []corev1.PodReadinessGate{
+		{ConditionType: "rollout.example.com/ordered"},
+	}
+
+	c := fake.NewClientBuilder().Build()
+	require.NoError(t, c.Create(ctx, consoleobj))
+	d := console.NewDeployment(c, scheme.Scheme, consoleobj, testCluster(), console.NewStore(c), ctrl.Log.WithName("test"))
+	require.NoError(t, d.Ensure(ctx))
+
+	actual := &appsv1.Deployment{}
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(consoleobj), actual))
+	assert.Equal(t, []corev1.PodReadinessGate{{ConditionType: "rollout.example.com/ordered"}}, actual.Spec.Template.Spec.ReadinessGates)
+}
+
+func TestEnsureDeployment_ImagePullSecrets(t *testing.T) {
+	require.NoError(t, redpandav1alpha1.AddToScheme(scheme.Scheme))
+	ctx := context.Background()
+
+	consoleobj := testConsole()
+	consoleobj.Spec.Deployment.ImagePullSecrets = []corev1.LocalObjectReference{{Name: "shared"}, {Name: "console"}}
+
+	c := fake.NewClientBuilder().Build()
+	require.NoError(t, c.Create(ctx, consoleobj))
+	// Pull secrets added to the ServiceAccount by someone else
+	require.NoError(t, c.Create(ctx, &corev1.ServiceAccount{
+		ObjectMeta:       metav1.ObjectMeta{Name: consoleobj.GetName(), Namespace: consoleobj.GetNamespace()},
+		ImagePullSecrets: []corev1.LocalObjectReference{{Name: "registry"}, {Name: "shared"}},
+	}))
+	d := console.NewDeployment(c, scheme.Scheme, consoleobj, testCluster(), console.NewStore(c), ctrl.Log.WithName("test"))
+	require.NoError(t, d.Ensure(ctx))
+
+	actual := &appsv1.Deployment{}
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(consoleobj), actual))
+	assert.Equal(t,
+		[]corev1.LocalObjectReference{{Name: "shared"}, {Name: "console"}, {Name: "registry"}},
+		actual.Spec.Template.Spec.ImagePullSecrets,
+	)
+
+	sa := &corev1.ServiceAccount{}
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(consoleobj), sa))
+	assert.Equal(t, []corev1.LocalObjectReference{{Name: "registry"}, {Name: "shared"}}, sa.ImagePullSecrets)
+}
+
+func TestEnsureDeployment_DefaultImagePullSecret(t *testing.T) {
+	require.NoError(t, redpandav1alpha1.AddToScheme(scheme.Scheme))
+	ctx := context.Background()
+
+	console.DefaultImagePullSecret = "mirror"
+	defer func() { console.DefaultImagePullSecret = "" }()
+
+	consoleobj := testConsole()
+	consoleobj.Spec.Deployment.ImagePullSecrets = []corev1.LocalObjectReference{{Name: "console"}, {Name: "mirror"}}
+
+	c := fake.NewClientBuilder().Build()
+	require.NoError(t, c.Create(ctx, consoleobj))
+	d := console.NewDeployment(c, scheme.Scheme, consoleobj, testCluster(), console.NewStore(c), ctrl.Log.WithName("test"))
+	require.NoError(t, d.Ensure(ctx))
+
+	actual := &appsv1.Deployment{}
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(consoleobj), actual))
+	assert.Equal(t,
+		[]corev1.LocalObjectReference{{Name: "console"}, {Name: "mirror"}},
+		actual.Spec.Template.Spec.ImagePullSecrets,
+	)
+
+	// Default pull secret is added to Consoles without pull secrets
+	consoleobj.Spec.Deployment.ImagePullSecrets = nil
+	require.NoError(t, d.Ensure(ctx))
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(consoleobj), actual))
+	assert.Equal(t, []corev1.LocalObjectReference{{Name: "mirror"}}, actual.Spec.Template.Spec.ImagePullSecrets)
+}
+
+func TestEnsureDeployment_MetricsPath(t *testing.T) {
+	require.NoError(t, redpandav1alpha1.AddToScheme(scheme.Scheme))
+	ctx := context.Background()
+
+	consoleobj := testConsole()
+	consoleobj.Spec.Deployment.Metrics = &redpandav1alpha1.DeploymentMetrics{Path: "/internal/metrics"}
+	cluster := testCluster()
+
+	c := fake.NewClientBuilder().Build()
+	require.NoError(t, c.Create(ctx, consoleobj))
+	d := console.NewDeployment(c, scheme.Scheme, consoleobj, cluster, console.NewStore(c), ctrl.Log.WithName("test"))
+	require.NoError(t, d.Ensure(ctx))
+
+	actual := &appsv1.Deployment{}
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(consoleobj), actual))
+	assert.Equal(t, map[string]string{
+		"prometheus.io/scrape": "true",
+		"prometheus.io/path":   "/internal/metrics",
+		"prometheus.io/port":   "8080",
+	}, actual.Spec.Template.Annotations)
+
+	// Console serves metrics at the default path only
+	ensureConfigUnsupported(t, c, consoleobj, cluster, "metricsPath")
+
+	// Path defaults to the Console metrics endpoint
+	consoleobj.Spec.Deployment.Metrics.Path = ""
+	cc := ensureConfig(t, c, consoleobj, cluster)
+	assert.Empty(t, cc.Server.MetricsPath)
+	require.NoError(t, d.Ensure(ctx))
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(consoleobj), actual))
+	assert.Equal(t, console.DefaultMetricsPath, actual.Spec.Template.Annotations["prometheus.io/path"])
+}
+
+func TestEnsureDeployment_KafkaTLSUseClusterCA(t *testing.T) {
+	require.NoError(t, redpandav1alpha1.AddToScheme(scheme.Scheme))
+	ctx := context.Background()
+
+	consoleobj := testConsole()
+	consoleobj.Spec.Kafka.TLS = &redpandav1alpha1.KafkaTLS{UseClusterCA: true}
+	cluster := testCluster()
+
+	c := fake.NewClientBuilder().Build()
+	require.NoError(t, c.Create(ctx, consoleobj))
+
+	// Cluster without Kafka TLS listener has no CA
+	consoleobj.Status.ConfigMapRef = nil
+	cm := console.NewConfigMap(c, scheme.Scheme, consoleobj, cluster, ctrl.Log.WithName("test"))
+	assert.Error(t, cm.Ensure(ctx))
+
+	cluster.Spec.Configuration.KafkaAPI[0].TLS.Enabled = true
+	cc := ensureConfig(t, c, consoleobj, cluster)
+	assert.True(t, cc.Kafka.TLS.Enabled)
+	assert.Equal(t, console.KafkaTLSCAFilePath, cc.Kafka.TLS.CaFilepath)
+
+	d := console.NewDeployment(c, scheme.Scheme, consoleobj, cluster, console.NewStore(c), ctrl.Log.WithName("test"))
+	require.NoError(t, d.Ensure(ctx))
+	actual := &appsv1.Deployment{}
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(consoleobj), actual))
+
+	secrets := map[string]*corev1.SecretVolumeSource{}
+	for _, v := range actual.Spec.Template.Spec.Volumes {
+		if v.Secret != nil {
+			secrets[v.Name] = v.Secret
+		}
+	}
+	require.Contains(t, secrets, "tls-kafka-ca")
+	assert.Equal(t, "cluster-redpanda", secrets["tls-kafka-ca"].SecretName)
+	assert.Equal(t, []corev1.KeyToPath{{Key: "ca.crt", Path: "ca.crt"}}, secrets["tls-kafka-ca"].Items)
+
+	mounts := map[string]string{}
+	for _, m := range actual.Spec.Template.Spec.Containers[0].VolumeMounts {
+		mounts[m.Name] = m.MountPath
+	}
+	assert.Equal(t, console.KafkaTLSDir, mounts["tls-kafka-ca"])
+}
+
+func TestEnsureDeployment_KafkaSASLKerberosConfig(t *testing.T) {
+	require.NoError(t, redpandav1alpha1.AddToScheme(scheme.Scheme))
+	ctx := context.Background()
+
+	consoleobj := testConsole()
+	consoleobj.Spec.Kafka.SASL = &redpandav1alpha1.KafkaSASL{
+		Mechanism:      redpandav1alpha1.KafkaSASLMechanismGSSAPI,
+		CredentialsRef: redpandav1alpha1.NamespaceNameRef{Name: "kafka-kerberos", Namespace: "default"},
+		Kerberos: &redpandav1alpha1.KafkaSASLKerberos{
+			Krb5ConfigMapRef: corev1.LocalObjectReference{Name: "krb5"},
+			Realm:            "CORP.COM",
+			KeytabSecretRef:  &corev1.LocalObjectReference{Name: "console-keytab"},
+		},
+	}
+	cluster := testCluster()
+
+	c := fake.NewClientBuilder().Build()
+	require.NoError(t, c.Create(ctx, consoleobj))
+	require.NoError(t, c.Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "kafka-kerberos", Namespace: "default"},
+		Data:       map[string][]byte{corev1.BasicAuthUsernameKey: []byte("console")},
+	}))
+
+	cc := ensureConfig(t, c, consoleobj, cluster)
+	assert.Equal(t, "/etc/krb5.conf", cc.Kafka.SASL.GSSAPIConfig.KerberosConfigPath)
+	assert.Equal(t, "/etc/console/kerberos/krb5.keytab", cc.Kafka.SASL.GSSAPIConfig.KeyTabPath)
+
+	d := console.NewDeployment(c, scheme.Scheme, consoleobj, cluster, console.NewStore(c), ctrl.Log.WithName("test"))
+	require.NoError(t, d.Ensure(ctx))
+	actual := &appsv1.Deployment{}
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(consoleobj), actual))
+
+	configMaps := map[string]*corev1.ConfigMapVolumeSource{}
+	for _, v := range actual.Spec.Template.Spec.Volumes {
+		if v.ConfigMap != nil {
+			configMaps[v.Name] = v.ConfigMap
+		}
+	}
+	require.Contains(t, configMaps, "krb5-conf")
+	assert.Equal(t, "krb5", configMaps["krb5-conf"].Name)
+	assert.Equal(t, []corev1.KeyToPath{{Key: "krb5.conf", Path: "krb5.conf"}}, configMaps["krb5-conf"].Items)
+
+	mounts := map[string]corev1.VolumeMount{}
+	for _, m := range actual.Spec.Template.Spec.Containers[0].VolumeMounts {
+		mounts[m.Name] = m
+	}
+	require.Contains(t, mounts, "krb5-conf")
+	assert.Equal(t, "/etc/krb5.conf", mounts["krb5-conf"].MountPath)
+	assert.Equal(t, "krb5.conf", mounts["krb5-conf"].SubPath)
+
+	secrets := map[string]*corev1.SecretVolumeSource{}
+	for _, v := range actual.Spec.Template.Spec.Volumes {
+		if v.Secret != nil {
+			secrets[v.Name] = v.Secret
+		}
+	}
+	require.Contains(t, secrets, "krb5-keytab")
+	assert.Equal(t, "console-keytab", secrets["krb5-keytab"].SecretName)
+	assert.Equal(t, []corev1.KeyToPath{{Key: "krb5.keytab", Path: "krb5.keytab"}}, secrets["krb5-keytab"].Items)
+	require.Contains(t, mounts, "krb5-keytab")
+	assert.Equal(t, "/etc/console/kerberos", mounts["krb5-keytab"].MountPath)
+}
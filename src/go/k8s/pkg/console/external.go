@@ -8,13 +8,14 @@ import (
 	redpandav1alpha1 "github.com/redpanda-data/redpanda/src/go/k8s/apis/redpanda/v1alpha1"
 	labels "github.com/redpanda-data/redpanda/src/go/k8s/pkg/labels"
 	"github.com/redpanda-data/redpanda/src/go/k8s/pkg/resources"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 )
 
 // Service is a Console resource
@@ -50,6 +51,18 @@ const (
 
 // Ensure implements Resource interface
 func (s *Service) Ensure(ctx context.Context) error {
+	if s.consoleobj.Spec.Server.CreateServiceWhenReady {
+		ready, err := s.isReadyForService(ctx)
+		if err != nil {
+			return err
+		}
+		if !ready {
+			// Deployment status changes trigger reconcile, the Service is created once available
+			s.log.Info("Waiting for Console Deployment to be available before creating Service")
+			return nil
+		}
+	}
+
 	objLabels := labels.ForConsole(s.consoleobj)
 	obj := &corev1.Service{
 		ObjectMeta: metav1.ObjectMeta{
@@ -62,7 +75,9 @@ func (s *Service) Ensure(ctx context.Context) error {
 			APIVersion: "v1",
 		},
 		Spec: corev1.ServiceSpec{
-			Type: corev1.ServiceTypeClusterIP,
+			Type:                  s.getServiceType(),
+			ClusterIP:             s.getClusterIP(),
+			ExternalTrafficPolicy: s.getExternalTrafficPolicy(),
 			Ports: []corev1.ServicePort{
 				{
 					Name:       ServicePortName,
@@ -74,7 +89,8 @@ func (s *Service) Ensure(ctx context.Context) error {
 		},
 	}
 
-	if err := controllerutil.SetControllerReference(s.consoleobj, obj, s.scheme); err != nil {
+	setHelmAnnotations(s.consoleobj, obj)
+	if err := setOwnerReference(s.consoleobj, obj, s.scheme); err != nil {
 		return err
 	}
 
@@ -90,12 +106,16 @@ func (s *Service) Ensure(ctx context.Context) error {
 		if err != nil {
 			return fmt.Errorf("fetching Console service: %w", err)
 		}
+		if err = s.recreateIfHeadlessChanged(ctx, &current, obj); err != nil {
+			return err
+		}
 		_, err = resources.Update(ctx, &current, obj, s.Client, s.log)
 		if err != nil {
 			return fmt.Errorf("updating Console service: %w", err)
 		}
 	}
 
+	// Headless Service DNS name resolves to the ready pod IPs, clients connect to the container port
 	s.consoleobj.Status.Connectivity = &redpandav1alpha1.Connectivity{
 		Internal: fmt.Sprintf(
 			"%s.%s.svc.%s:%d",
@@ -104,7 +124,77 @@ func (s *Service) Ensure(ctx context.Context) error {
 			s.consoleobj.Spec.Server.HTTPListenPort,
 		),
 	}
-	return s.Status().Update(ctx, s.consoleobj)
+	return UpdateStatus(ctx, s.Client, s.consoleobj)
+}
+
+// isReadyForService returns true if the Service exists or the Deployment is available
+func (s *Service) isReadyForService(ctx context.Context) (bool, error) {
+	key := types.NamespacedName{Name: s.consoleobj.GetName(), Namespace: s.consoleobj.GetNamespace()}
+	if err := s.Get(ctx, key, &corev1.Service{}); err == nil {
+		return true, nil
+	} else if !apierrors.IsNotFound(err) {
+		return false, fmt.Errorf("fetching Console service: %w", err)
+	}
+
+	deployment := &appsv1.Deployment{}
+	if err := s.Get(ctx, key, deployment); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("fetching Console deployment: %w", err)
+	}
+	for _, c := range deployment.Status.Conditions {
+		if c.Type == appsv1.DeploymentAvailable {
+			return c.Status == corev1.ConditionTrue, nil
+		}
+	}
+	return false, nil
+}
+
+// recreateIfHeadlessChanged deletes and creates the Service if Headless is toggled because ClusterIP is immutable
+// current is refreshed to the recreated Service
+func (s *Service) recreateIfHeadlessChanged(ctx context.Context, current, obj *corev1.Service) error {
+	if (current.Spec.ClusterIP == corev1.ClusterIPNone) == s.consoleobj.Spec.Server.Headless {
+		return nil
+	}
+	s.log.Info("Recreating Console service, headless changed", "headless", s.consoleobj.Spec.Server.Headless)
+	if err := s.Delete(ctx, current); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("deleting Console service: %w", err)
+	}
+	if err := s.Create(ctx, obj.DeepCopy()); err != nil {
+		return fmt.Errorf("creating Console service: %w", err)
+	}
+	if err := s.Get(ctx, types.NamespacedName{Name: obj.GetName(), Namespace: obj.GetNamespace()}, current); err != nil {
+		return fmt.Errorf("fetching Console service: %w", err)
+	}
+	return nil
+}
+
+// getClusterIP returns "None" for a headless Service, otherwise the cluster IP is allocated
+func (s *Service) getClusterIP() string {
+	if s.consoleobj.Spec.Server.Headless {
+		return corev1.ClusterIPNone
+	}
+	return ""
+}
+
+func (s *Service) getServiceType() corev1.ServiceType {
+	if s.consoleobj.Spec.Server.Headless {
+		return corev1.ServiceTypeClusterIP
+	}
+	if t := s.consoleobj.Spec.Server.ServiceType; t != "" {
+		return t
+	}
+	return corev1.ServiceTypeClusterIP
+}
+
+// getExternalTrafficPolicy returns the external traffic policy
+// The policy is rejected by the API server for ClusterIP Services
+func (s *Service) getExternalTrafficPolicy() corev1.ServiceExternalTrafficPolicyType {
+	if s.getServiceType() == corev1.ServiceTypeClusterIP {
+		return ""
+	}
+	return s.consoleobj.Spec.Server.ExternalTrafficPolicy
 }
 
 // Key implements Resource interface
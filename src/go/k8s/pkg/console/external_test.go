@@ -0,0 +1,104 @@
+// Copyright 2022 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package console_test
+
+import (
+	"context"
+	"testing"
+
+	redpandav1alpha1 "github.com/redpanda-data/redpanda/src/go/k8s/apis/redpanda/v1alpha1"
+	"github.com/redpanda-data/redpanda/src/go/k8s/pkg/console"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestEnsureService_ExternalTrafficPolicy(t *testing.T) {
+	require.NoError(t, redpandav1alpha1.AddToScheme(scheme.Scheme))
+	ctx := context.Background()
+
+	consoleobj := testConsole()
+	consoleobj.Spec.Server.ServiceType = corev1.ServiceTypeLoadBalancer
+	consoleobj.Spec.Server.ExternalTrafficPolicy = corev1.ServiceExternalTrafficPolicyTypeLocal
+
+	c := fake.NewClientBuilder().Build()
+	require.NoError(t, c.Create(ctx, consoleobj))
+	require.NoError(t, console.NewService(c, scheme.Scheme, consoleobj, "cluster.local", ctrl.Log.WithName("test")).Ensure(ctx))
+
+	actual := &corev1.Service{}
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(consoleobj), actual))
+	assert.Equal(t, corev1.ServiceTypeLoadBalancer, actual.Spec.Type)
+	assert.Equal(t, corev1.ServiceExternalTrafficPolicyTypeLocal, actual.Spec.ExternalTrafficPolicy)
+}
+
+func TestEnsureService_CreateServiceWhenReady(t *testing.T) {
+	require.NoError(t, redpandav1alpha1.AddToScheme(scheme.Scheme))
+	ctx := context.Background()
+
+	consoleobj := testConsole()
+	consoleobj.Spec.Server.CreateServiceWhenReady = true
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: consoleobj.GetName(), Namespace: consoleobj.GetNamespace()},
+		Status: appsv1.DeploymentStatus{
+			Conditions: []appsv1.DeploymentCondition{{Type: appsv1.DeploymentAvailable, Status: corev1.ConditionFalse}},
+		},
+	}
+
+	c := fake.NewClientBuilder().Build()
+	require.NoError(t, c.Create(ctx, consoleobj))
+	require.NoError(t, c.Create(ctx, deployment))
+	svc := console.NewService(c, scheme.Scheme, consoleobj, "cluster.local", ctrl.Log.WithName("test"))
+
+	// Service is withheld until the Deployment is available
+	require.NoError(t, svc.Ensure(ctx))
+	assert.True(t, apierrors.IsNotFound(c.Get(ctx, client.ObjectKeyFromObject(consoleobj), &corev1.Service{})))
+
+	deployment.Status.Conditions[0].Status = corev1.ConditionTrue
+	require.NoError(t, c.Status().Update(ctx, deployment))
+	require.NoError(t, svc.Ensure(ctx))
+	assert.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(consoleobj), &corev1.Service{}))
+}
+
+func TestEnsureService_Headless(t *testing.T) {
+	require.NoError(t, redpandav1alpha1.AddToScheme(scheme.Scheme))
+	ctx := context.Background()
+
+	consoleobj := testConsole()
+	consoleobj.Spec.Server.ServiceType = corev1.ServiceTypeNodePort
+
+	c := fake.NewClientBuilder().Build()
+	require.NoError(t, c.Create(ctx, consoleobj))
+	svc := console.NewService(c, scheme.Scheme, consoleobj, "cluster.local", ctrl.Log.WithName("test"))
+	require.NoError(t, svc.Ensure(ctx))
+
+	// Service is recreated as ClusterIP is immutable
+	consoleobj.Spec.Server.Headless = true
+	require.NoError(t, svc.Ensure(ctx))
+
+	actual := &corev1.Service{}
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(consoleobj), actual))
+	assert.Equal(t, corev1.ServiceTypeClusterIP, actual.Spec.Type)
+	assert.Equal(t, corev1.ClusterIPNone, actual.Spec.ClusterIP)
+	require.NotNil(t, consoleobj.Status.Connectivity)
+	// Headless Service DNS name resolves to the ready pod IPs
+	assert.Equal(t, "console.default.svc.cluster.local:8080", consoleobj.Status.Connectivity.Internal)
+
+	// Address is the same with a cluster IP
+	consoleobj.Spec.Server.Headless = false
+	require.NoError(t, svc.Ensure(ctx))
+	assert.Equal(t, "console.default.svc.cluster.local:8080", consoleobj.Status.Connectivity.Internal)
+}
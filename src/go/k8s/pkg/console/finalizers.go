@@ -0,0 +1,44 @@
+package console
+
+import (
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// DefaultFinalizerPrefix is the default prefix of the finalizers added to Console
+const DefaultFinalizerPrefix = "consoles.redpanda.vectorized.io"
+
+const (
+	// ConsoleSAFinalizer is the finalizer for deleting Service Account
+	ConsoleSAFinalizer = DefaultFinalizerPrefix + "/service-account"
+
+	// ConsoleACLFinalizer is the finalizer for deleting ACLs
+	ConsoleACLFinalizer = DefaultFinalizerPrefix + "/acl"
+
+	// ConsoleCleanupFinalizer is the finalizer for deleting generated resources when owner references are disabled
+	ConsoleCleanupFinalizer = DefaultFinalizerPrefix + "/cleanup"
+)
+
+// Finalizer returns the finalizer with the given prefix instead of DefaultFinalizerPrefix
+// The prefix allows multiple operators to manage finalizers without collisions, an empty prefix is the default prefix
+func Finalizer(prefix, finalizer string) string {
+	if prefix == "" {
+		return finalizer
+	}
+	return prefix + strings.TrimPrefix(finalizer, DefaultFinalizerPrefix)
+}
+
+// containsFinalizer returns true if the object has the finalizer with the given prefix or the default prefix
+func containsFinalizer(obj client.Object, prefix, finalizer string) bool {
+	return controllerutil.ContainsFinalizer(obj, Finalizer(prefix, finalizer)) ||
+		controllerutil.ContainsFinalizer(obj, finalizer)
+}
+
+// removeFinalizer removes the finalizer with the given prefix and the default prefix
+// Consoles reconciled before the prefix was set have the default finalizer, deletion would be blocked otherwise
+func removeFinalizer(obj client.Object, prefix, finalizer string) {
+	controllerutil.RemoveFinalizer(obj, Finalizer(prefix, finalizer))
+	controllerutil.RemoveFinalizer(obj, finalizer)
+}
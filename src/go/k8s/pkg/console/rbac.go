@@ -0,0 +1,275 @@
+package console
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/go-logr/logr"
+	redpandav1alpha1 "github.com/redpanda-data/redpanda/src/go/k8s/apis/redpanda/v1alpha1"
+	"gopkg.in/yaml.v2"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Login provider names used in RBAC role binding subjects
+const (
+	RoleBindingProviderGoogle        = "Google"
+	RoleBindingProviderRedpandaCloud = "RedpandaCloud"
+	RoleBindingProviderOIDC          = "OIDC"
+	RoleBindingProviderGitHub        = "GitHub"
+)
+
+// RoleBindings is a Console resource
+// It validates that RBAC role binding subjects reference enabled login providers
+// and that resource names of role permissions are valid, the wildcard "*" matches all resources
+// Roles can inherit the permissions of other roles, inheritance must not have cycles
+type RoleBindings struct {
+	client.Client
+	consoleobj *redpandav1alpha1.Console
+	log        logr.Logger
+}
+
+// NewRoleBindings instantiates a new RoleBindings
+func NewRoleBindings(
+	cl client.Client,
+	consoleobj *redpandav1alpha1.Console,
+	log logr.Logger,
+) *RoleBindings {
+	return &RoleBindings{
+		Client:     cl,
+		consoleobj: consoleobj,
+		log:        log,
+	}
+}
+
+// RBACWildcard matches all resource names in role permissions, e.g. all topics
+const RBACWildcard = "*"
+
+// roleBindingsFile is the part of the RBAC file that is validated
+type roleBindingsFile struct {
+	Roles []struct {
+		Name        string   `yaml:"name"`
+		Inherits    []string `yaml:"inherits"`
+		Permissions []struct {
+			Resource string   `yaml:"resource"`
+			Includes []string `yaml:"includes"`
+			Excludes []string `yaml:"excludes"`
+		} `yaml:"permissions"`
+	} `yaml:"roles"`
+	RoleBindings []struct {
+		RoleName string `yaml:"roleName"`
+		Subjects []struct {
+			Kind     string `yaml:"kind"`
+			Provider string `yaml:"provider"`
+			Name     string `yaml:"name"`
+		} `yaml:"subjects"`
+	} `yaml:"roleBindings"`
+}
+
+// Ensure implements Resource interface
+// Sets the RoleBindingsInvalid condition, invalid role bindings don't stop reconciliation
+func (r *RoleBindings) Ensure(ctx context.Context) error {
+	enterprise := r.consoleobj.Spec.Enterprise
+	if enterprise == nil || !enterprise.RBAC.Enabled {
+		return nil
+	}
+
+	cm := corev1.ConfigMap{}
+	key := types.NamespacedName{Namespace: r.consoleobj.GetNamespace(), Name: enterprise.RBAC.RoleBindingsRef.Name}
+	if err := r.Get(ctx, key, &cm); err != nil {
+		if apierrors.IsNotFound(err) {
+			// Reported in Status.UnresolvedRefs
+			return nil
+		}
+		return fmt.Errorf("getting RBAC ConfigMap %s: %w", key, err)
+	}
+
+	file := roleBindingsFile{}
+	if err := yaml.Unmarshal([]byte(cm.Data[EnterpriseRBACDataKey]), &file); err != nil {
+		// Console reports invalid RBAC file, role bindings are validated once it is fixed
+		r.log.Info("Skipping role bindings validation, RBAC file cannot be parsed", "configmap", key, "error", err.Error())
+		return nil
+	}
+
+	enabled := r.enabledProviders()
+	var invalid []string
+	for _, b := range file.RoleBindings {
+		for _, s := range b.Subjects {
+			provider := normalizeProvider(s.Provider)
+			if !enabled[provider] {
+				invalid = append(invalid, fmt.Sprintf("role %q subject %q references provider %q", b.RoleName, s.Name, s.Provider))
+			}
+		}
+	}
+
+	invalidPermissions := file.invalidPermissions()
+	cycles := file.inheritanceCycles()
+
+	// All problems are reported, the reason is the most severe one
+	var problems []string
+	reason := ""
+	if len(invalidPermissions) > 0 {
+		problems = append(problems, fmt.Sprintf("Role permissions have invalid resource names: %s", strings.Join(invalidPermissions, "; ")))
+		reason = redpandav1alpha1.RoleBindingsInvalidReasonInvalidPermission
+	}
+	if len(cycles) > 0 {
+		problems = append(problems, fmt.Sprintf("Role inheritance has cycles: %s", strings.Join(cycles, "; ")))
+		if reason == "" {
+			reason = redpandav1alpha1.RoleBindingsInvalidReasonInheritanceCycle
+		}
+	}
+	if len(invalid) > 0 {
+		providers := make([]string, 0, len(enabled))
+		for p := range enabled {
+			providers = append(providers, p)
+		}
+		sort.Strings(providers)
+		problems = append(problems, fmt.Sprintf("Role bindings reference login providers that are not enabled (enabled: %s): %s", strings.Join(providers, ", "), strings.Join(invalid, "; ")))
+		if reason == "" {
+			reason = redpandav1alpha1.RoleBindingsInvalidReasonProviderDisabled
+		}
+	}
+
+	var changed bool
+	if len(problems) > 0 {
+		msg := strings.Join(problems, ". ")
+		r.log.Info(msg)
+		changed = r.consoleobj.Status.SetCondition(
+			redpandav1alpha1.RoleBindingsInvalidConditionType,
+			corev1.ConditionTrue,
+			reason,
+			msg,
+		)
+	} else {
+		changed = r.consoleobj.Status.SetCondition(
+			redpandav1alpha1.RoleBindingsInvalidConditionType,
+			corev1.ConditionFalse,
+			redpandav1alpha1.RoleBindingsInvalidReasonValid,
+			"",
+		)
+	}
+	if !changed {
+		return nil
+	}
+	return UpdateStatus(ctx, r.Client, r.consoleobj)
+}
+
+// Key implements Resource interface
+// But this is not a single K8s resource, not implemented
+func (r *RoleBindings) Key() (nsn types.NamespacedName) {
+	return nsn
+}
+
+// enabledProviders returns the login providers enabled in Console
+func (r *RoleBindings) enabledProviders() map[string]bool {
+	enabled := map[string]bool{}
+	login := r.consoleobj.Spec.Login
+	if login == nil || !login.Enabled {
+		return enabled
+	}
+	if r.consoleobj.IsGoogleLoginEnabled() {
+		enabled[RoleBindingProviderGoogle] = true
+	}
+	if login.RedpandaCloud != nil && login.RedpandaCloud.Enabled {
+		enabled[RoleBindingProviderRedpandaCloud] = true
+	}
+	if r.consoleobj.IsOIDCLoginEnabled() {
+		enabled[RoleBindingProviderOIDC] = true
+	}
+	if r.consoleobj.IsGitHubLoginEnabled() {
+		enabled[RoleBindingProviderGitHub] = true
+	}
+	return enabled
+}
+
+// invalidPermissions returns the role permissions with invalid resource names
+// A name is the wildcard "*", a regular expression enclosed in slashes, e.g. "/^team-.*/", or a literal name
+func (f *roleBindingsFile) invalidPermissions() []string {
+	var invalid []string
+	for _, role := range f.Roles {
+		for _, p := range role.Permissions {
+			for _, names := range [][]string{p.Includes, p.Excludes} {
+				for _, name := range names {
+					if err := validateResourceName(name); err != nil {
+						invalid = append(invalid, fmt.Sprintf("role %q %s %q: %s", role.Name, p.Resource, name, err))
+					}
+				}
+			}
+		}
+	}
+	return invalid
+}
+
+// inheritanceCycles returns the cycles in role inheritance, e.g. "admin -> editor -> admin"
+// Inherited roles that are not defined are skipped, Console reports them
+func (f *roleBindingsFile) inheritanceCycles() []string {
+	inherits := map[string][]string{}
+	for _, role := range f.Roles {
+		inherits[role.Name] = role.Inherits
+	}
+
+	const (
+		visiting = iota + 1
+		visited
+	)
+	state := map[string]int{}
+	var path, cycles []string
+	var visit func(name string)
+	visit = func(name string) {
+		switch state[name] {
+		case visiting:
+			for i := range path {
+				if path[i] == name {
+					cycle := append(append([]string{}, path[i:]...), name)
+					cycles = append(cycles, strings.Join(cycle, " -> "))
+					break
+				}
+			}
+			return
+		case visited:
+			return
+		}
+		state[name] = visiting
+		path = append(path, name)
+		for _, parent := range inherits[name] {
+			if _, ok := inherits[parent]; ok {
+				visit(parent)
+			}
+		}
+		path = path[:len(path)-1]
+		state[name] = visited
+	}
+	for _, role := range f.Roles {
+		visit(role.Name)
+	}
+	return cycles
+}
+
+func validateResourceName(name string) error {
+	switch {
+	case name == RBACWildcard:
+		return nil
+	case name == "":
+		return fmt.Errorf("name must not be empty") //nolint:goerr113 // no need to declare new error type
+	case len(name) > 1 && strings.HasPrefix(name, "/") && strings.HasSuffix(name, "/"):
+		if _, err := regexp.Compile(strings.Trim(name, "/")); err != nil {
+			return fmt.Errorf("invalid regular expression: %w", err)
+		}
+	}
+	return nil
+}
+
+// normalizeProvider returns the canonical provider name, matching case-insensitively, e.g. "google" is "Google"
+func normalizeProvider(provider string) string {
+	for _, p := range []string{RoleBindingProviderGoogle, RoleBindingProviderRedpandaCloud, RoleBindingProviderOIDC, RoleBindingProviderGitHub} {
+		if strings.EqualFold(provider, p) {
+			return p
+		}
+	}
+	return provider
+}
@@ -0,0 +1,267 @@
+// Copyright 2022 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package console_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	redpandav1alpha1 "github.com/redpanda-data/redpanda/src/go/k8s/apis/redpanda/v1alpha1"
+	"github.com/redpanda-data/redpanda/src/go/k8s/pkg/console"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const testRoleBindings = `roleBindings:
+- roleName: admin
+  subjects:
+  - kind: user
+    provider: google
+    name: john.doe@redpanda.com
+`
+
+func TestEnsureRoleBindings_ProviderDisabled(t *testing.T) {
+	require.NoError(t, redpandav1alpha1.AddToScheme(scheme.Scheme))
+	ctx := context.Background()
+
+	consoleobj := testConsole()
+	consoleobj.Spec.Enterprise = &redpandav1alpha1.Enterprise{
+		RBAC: redpandav1alpha1.EnterpriseRBAC{
+			Enabled:         true,
+			RoleBindingsRef: corev1.LocalObjectReference{Name: "rbac"},
+		},
+	}
+	consoleobj.Spec.Login = &redpandav1alpha1.EnterpriseLogin{
+		Enabled:       true,
+		RedpandaCloud: &redpandav1alpha1.EnterpriseLoginRedpandaCloud{Enabled: true},
+	}
+
+	c := fake.NewClientBuilder().Build()
+	require.NoError(t, c.Create(ctx, consoleobj))
+	require.NoError(t, c.Create(ctx, &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "rbac", Namespace: "default"},
+		Data:       map[string]string{console.EnterpriseRBACDataKey: testRoleBindings},
+	}))
+
+	ensure := func() *redpandav1alpha1.ConsoleCondition {
+		require.NoError(t, console.NewRoleBindings(c, consoleobj, ctrl.Log.WithName("test")).Ensure(ctx))
+		actual := &redpandav1alpha1.Console{}
+		require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(consoleobj), actual))
+		return actual.Status.GetCondition(redpandav1alpha1.RoleBindingsInvalidConditionType)
+	}
+
+	// Google login is not enabled
+	cond := ensure()
+	require.NotNil(t, cond)
+	assert.Equal(t, corev1.ConditionTrue, cond.Status)
+	assert.Equal(t, redpandav1alpha1.RoleBindingsInvalidReasonProviderDisabled, cond.Reason)
+	assert.Contains(t, cond.Message, `provider "google"`)
+
+	// Provider is matched case-insensitively once Google login is enabled
+	consoleobj.Spec.Login.Google = &redpandav1alpha1.EnterpriseLoginGoogle{
+		Enabled:              true,
+		ClientCredentialsRef: redpandav1alpha1.NamespaceNameRef{Name: "google", Namespace: "default"},
+	}
+	cond = ensure()
+	require.NotNil(t, cond)
+	assert.Equal(t, corev1.ConditionFalse, cond.Status)
+	assert.Equal(t, redpandav1alpha1.RoleBindingsInvalidReasonValid, cond.Reason)
+}
+
+func TestEnsureRoleBindings_Unparsable(t *testing.T) {
+	require.NoError(t, redpandav1alpha1.AddToScheme(scheme.Scheme))
+	ctx := context.Background()
+
+	consoleobj := testConsole()
+	consoleobj.Spec.Enterprise = &redpandav1alpha1.Enterprise{
+		RBAC: redpandav1alpha1.EnterpriseRBAC{
+			Enabled:         true,
+			RoleBindingsRef: corev1.LocalObjectReference{Name: "rbac"},
+		},
+	}
+
+	c := fake.NewClientBuilder().Build()
+	require.NoError(t, c.Create(ctx, consoleobj))
+	require.NoError(t, c.Create(ctx, &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "rbac", Namespace: "default"},
+		Data:       map[string]string{console.EnterpriseRBACDataKey: "roleBindings: [unclosed"},
+	}))
+
+	// Console reports the invalid file, validation is skipped without failing the reconcile
+	require.NoError(t, console.NewRoleBindings(c, consoleobj, ctrl.Log.WithName("test")).Ensure(ctx))
+	assert.Nil(t, consoleobj.Status.GetCondition(redpandav1alpha1.RoleBindingsInvalidConditionType))
+	actual := &redpandav1alpha1.Console{}
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(consoleobj), actual))
+	assert.Nil(t, actual.Status.GetCondition(redpandav1alpha1.RoleBindingsInvalidConditionType))
+}
+
+const testRolePermissions = `roles:
+- name: admin
+  permissions:
+  - resource: topics
+    includes: ["*"]
+    excludes: ["/^_internal.*/"]
+roleBindings:
+- roleName: admin
+  subjects:
+  - kind: user
+    provider: RedpandaCloud
+    name: john.doe@redpanda.com
+`
+
+func TestEnsureRoleBindings_WildcardPermission(t *testing.T) {
+	require.NoError(t, redpandav1alpha1.AddToScheme(scheme.Scheme))
+	ctx := context.Background()
+
+	consoleobj := testConsole()
+	consoleobj.Spec.Enterprise = &redpandav1alpha1.Enterprise{
+		RBAC: redpandav1alpha1.EnterpriseRBAC{
+			Enabled:         true,
+			RoleBindingsRef: corev1.LocalObjectReference{Name: "rbac"},
+		},
+	}
+	consoleobj.Spec.Login = &redpandav1alpha1.EnterpriseLogin{
+		Enabled:       true,
+		JWTSecretRef:  redpandav1alpha1.SecretKeyRef{Name: "jwt", Namespace: "default"},
+		RedpandaCloud: &redpandav1alpha1.EnterpriseLoginRedpandaCloud{Enabled: true},
+	}
+
+	rbac := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "rbac", Namespace: "default"},
+		Data:       map[string]string{console.EnterpriseRBACDataKey: testRolePermissions},
+	}
+	c := fake.NewClientBuilder().Build()
+	require.NoError(t, c.Create(ctx, consoleobj))
+	require.NoError(t, c.Create(ctx, rbac))
+	require.NoError(t, c.Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "jwt", Namespace: "default"},
+		Data:       map[string][]byte{console.DefaultJWTSecretKey: []byte("jwt")},
+	}))
+
+	ensure := func() *redpandav1alpha1.ConsoleCondition {
+		require.NoError(t, console.NewRoleBindings(c, consoleobj, ctrl.Log.WithName("test")).Ensure(ctx))
+		actual := &redpandav1alpha1.Console{}
+		require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(consoleobj), actual))
+		return actual.Status.GetCondition(redpandav1alpha1.RoleBindingsInvalidConditionType)
+	}
+
+	// Wildcard topic permission is valid
+	cond := ensure()
+	require.NotNil(t, cond)
+	assert.Equal(t, corev1.ConditionFalse, cond.Status)
+	assert.Equal(t, redpandav1alpha1.RoleBindingsInvalidReasonValid, cond.Reason)
+
+	// The RBAC file is rendered as is
+	cc := ensureConfig(t, c, consoleobj, testCluster())
+	assert.True(t, cc.Enterprise.RBAC.Enabled)
+	assert.Equal(t, "/etc/console/enterprise/rbac/"+console.EnterpriseRBACDataKey, cc.Enterprise.RBAC.RoleBindingsFilepath)
+
+	// Invalid regular expression is reported
+	rbac.Data[console.EnterpriseRBACDataKey] = strings.Replace(testRolePermissions, "/^_internal.*/", "/^_internal(/", 1)
+	require.NoError(t, c.Update(ctx, rbac))
+	cond = ensure()
+	require.NotNil(t, cond)
+	assert.Equal(t, corev1.ConditionTrue, cond.Status)
+	assert.Equal(t, redpandav1alpha1.RoleBindingsInvalidReasonInvalidPermission, cond.Reason)
+	assert.Contains(t, cond.Message, `"/^_internal(/"`)
+}
+
+const testRoleInheritance = `roles:
+- name: viewer
+  permissions:
+  - resource: topics
+    includes: ["*"]
+- name: editor
+  inherits: [viewer]
+- name: admin
+  inherits: [editor]
+roleBindings:
+- roleName: admin
+  subjects:
+  - kind: user
+    provider: RedpandaCloud
+    name: john.doe@redpanda.com
+`
+
+func TestEnsureRoleBindings_RoleInheritance(t *testing.T) {
+	require.NoError(t, redpandav1alpha1.AddToScheme(scheme.Scheme))
+	ctx := context.Background()
+
+	consoleobj := testConsole()
+	consoleobj.Spec.Enterprise = &redpandav1alpha1.Enterprise{
+		RBAC: redpandav1alpha1.EnterpriseRBAC{
+			Enabled:         true,
+			RoleBindingsRef: corev1.LocalObjectReference{Name: "rbac"},
+		},
+	}
+	consoleobj.Spec.Login = &redpandav1alpha1.EnterpriseLogin{
+		Enabled:       true,
+		JWTSecretRef:  redpandav1alpha1.SecretKeyRef{Name: "jwt", Namespace: "default"},
+		RedpandaCloud: &redpandav1alpha1.EnterpriseLoginRedpandaCloud{Enabled: true},
+	}
+
+	rbac := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "rbac", Namespace: "default"},
+		Data:       map[string]string{console.EnterpriseRBACDataKey: testRoleInheritance},
+	}
+	c := fake.NewClientBuilder().Build()
+	require.NoError(t, c.Create(ctx, consoleobj))
+	require.NoError(t, c.Create(ctx, rbac))
+	require.NoError(t, c.Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "jwt", Namespace: "default"},
+		Data:       map[string][]byte{console.DefaultJWTSecretKey: []byte("jwt")},
+	}))
+
+	ensure := func() *redpandav1alpha1.ConsoleCondition {
+		require.NoError(t, console.NewRoleBindings(c, consoleobj, ctrl.Log.WithName("test")).Ensure(ctx))
+		actual := &redpandav1alpha1.Console{}
+		require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(consoleobj), actual))
+		return actual.Status.GetCondition(redpandav1alpha1.RoleBindingsInvalidConditionType)
+	}
+
+	// Inheritance chain is valid
+	cond := ensure()
+	require.NotNil(t, cond)
+	assert.Equal(t, corev1.ConditionFalse, cond.Status)
+	assert.Equal(t, redpandav1alpha1.RoleBindingsInvalidReasonValid, cond.Reason)
+
+	// The RBAC file with inherited roles is rendered as is
+	cc := ensureConfig(t, c, consoleobj, testCluster())
+	assert.True(t, cc.Enterprise.RBAC.Enabled)
+	assert.Equal(t, "/etc/console/enterprise/rbac/"+console.EnterpriseRBACDataKey, cc.Enterprise.RBAC.RoleBindingsFilepath)
+
+	// Cycle is reported
+	rbac.Data[console.EnterpriseRBACDataKey] = strings.Replace(testRoleInheritance, "name: viewer\n", "name: viewer\n  inherits: [admin]\n", 1)
+	require.NoError(t, c.Update(ctx, rbac))
+	cond = ensure()
+	require.NotNil(t, cond)
+	assert.Equal(t, corev1.ConditionTrue, cond.Status)
+	assert.Equal(t, redpandav1alpha1.RoleBindingsInvalidReasonInheritanceCycle, cond.Reason)
+	assert.Contains(t, cond.Message, "viewer -> admin -> editor -> viewer")
+
+	// All problems are reported, the reason is the most severe one
+	invalid := strings.Replace(rbac.Data[console.EnterpriseRBACDataKey], `includes: ["*"]`, `includes: ["/(/"]`, 1)
+	rbac.Data[console.EnterpriseRBACDataKey] = strings.Replace(invalid, "provider: RedpandaCloud", "provider: Google", 1)
+	require.NoError(t, c.Update(ctx, rbac))
+	cond = ensure()
+	require.NotNil(t, cond)
+	assert.Equal(t, corev1.ConditionTrue, cond.Status)
+	assert.Equal(t, redpandav1alpha1.RoleBindingsInvalidReasonInvalidPermission, cond.Reason)
+	assert.Contains(t, cond.Message, `"/(/"`)
+	assert.Contains(t, cond.Message, "viewer -> admin -> editor -> viewer")
+	assert.Contains(t, cond.Message, `references provider "Google"`)
+}
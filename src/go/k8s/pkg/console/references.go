@@ -0,0 +1,182 @@
+package console
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/go-logr/logr"
+	redpandav1alpha1 "github.com/redpanda-data/redpanda/src/go/k8s/apis/redpanda/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// References is a Console resource
+// It reports Secrets and ConfigMaps referenced by Console that can't be resolved
+type References struct {
+	client.Client
+	consoleobj *redpandav1alpha1.Console
+	log        logr.Logger
+}
+
+// NewReferences instantiates a new References
+func NewReferences(
+	cl client.Client,
+	consoleobj *redpandav1alpha1.Console,
+	log logr.Logger,
+) *References {
+	return &References{
+		Client:     cl,
+		consoleobj: consoleobj,
+		log:        log,
+	}
+}
+
+// Ensure implements Resource interface
+// Sets Status.UnresolvedRefs, the list is cleared once all references are resolved
+// The status is updated here because resources that use the references fail reconciliation
+func (r *References) Ensure(ctx context.Context) error {
+	unique := map[string]bool{}
+	check := func(kind string, obj client.Object, key types.NamespacedName) error {
+		if err := r.Get(ctx, key, obj); err != nil {
+			if !apierrors.IsNotFound(err) {
+				return fmt.Errorf("getting %s %s: %w", kind, key, err)
+			}
+			unique[fmt.Sprintf("%s %s", kind, key)] = true
+		}
+		return nil
+	}
+
+	for _, ref := range r.secretRefs() {
+		if err := check("Secret", &corev1.Secret{}, ref); err != nil {
+			return err
+		}
+	}
+	for _, ref := range r.configMapRefs() {
+		if err := check("ConfigMap", &corev1.ConfigMap{}, ref); err != nil {
+			return err
+		}
+	}
+
+	var unresolved []string
+	for ref := range unique {
+		unresolved = append(unresolved, ref)
+	}
+	sort.Strings(unresolved)
+
+	if reflect.DeepEqual(unresolved, r.consoleobj.Status.UnresolvedRefs) {
+		return nil
+	}
+	if len(unresolved) > 0 {
+		r.log.Info("Console references can't be resolved", "refs", unresolved)
+	}
+	r.consoleobj.Status.UnresolvedRefs = unresolved
+	return UpdateStatus(ctx, r.Client, r.consoleobj)
+}
+
+// Key implements Resource interface
+// But this is not a single K8s resource, not implemented
+func (r *References) Key() (nsn types.NamespacedName) {
+	return nsn
+}
+
+// secretRefs returns the Secrets referenced by Console
+func (r *References) secretRefs() []types.NamespacedName {
+	spec := r.consoleobj.Spec
+	refs := []types.NamespacedName{}
+	if spec.LicenseRef != nil {
+		refs = append(refs, types.NamespacedName{Namespace: spec.LicenseRef.Namespace, Name: spec.LicenseRef.Name})
+	}
+	if r.consoleobj.IsExternalSASLEnabled() {
+		refs = append(refs, types.NamespacedName{Namespace: spec.Kafka.SASL.CredentialsRef.Namespace, Name: spec.Kafka.SASL.CredentialsRef.Name})
+	}
+	if spec.SchemaRegistry.Enabled && spec.SchemaRegistry.BasicAuthRef != nil {
+		refs = append(refs, SchemaRegistryBasicAuthKey(r.consoleobj))
+	}
+	if spec.SchemaRegistry.Enabled && spec.SchemaRegistry.PasswordRef != nil {
+		refs = append(refs, types.NamespacedName{Namespace: spec.SchemaRegistry.PasswordRef.Namespace, Name: spec.SchemaRegistry.PasswordRef.Name})
+	}
+	if proxy := spec.Kafka.Proxy; proxy != nil && proxy.CredentialsRef != nil {
+		refs = append(refs, types.NamespacedName{Namespace: proxy.CredentialsRef.Namespace, Name: proxy.CredentialsRef.Name})
+	}
+	if auth := spec.Server.MetricsAuth; auth != nil {
+		refs = append(refs, types.NamespacedName{Namespace: auth.BasicAuthRef.Namespace, Name: auth.BasicAuthRef.Name})
+	}
+	if login := spec.Login; login != nil {
+		refs = append(refs, types.NamespacedName{Namespace: login.JWTSecretRef.Namespace, Name: login.JWTSecretRef.Name})
+		if rotation := login.JWTRotation; rotation != nil && rotation.AllowPreviousSecretRef != nil {
+			refs = append(refs, types.NamespacedName{Namespace: rotation.AllowPreviousSecretRef.Namespace, Name: rotation.AllowPreviousSecretRef.Name})
+		}
+		if r.consoleobj.IsGoogleLoginEnabled() {
+			refs = append(refs, types.NamespacedName{Namespace: login.Google.ClientCredentialsRef.Namespace, Name: login.Google.ClientCredentialsRef.Name})
+		}
+		if r.consoleobj.IsOIDCLoginEnabled() {
+			refs = append(refs, types.NamespacedName{Namespace: login.OIDC.ClientCredentialsRef.Namespace, Name: login.OIDC.ClientCredentialsRef.Name})
+		}
+		if r.consoleobj.IsGitHubLoginEnabled() {
+			refs = append(refs, types.NamespacedName{Namespace: login.GitHub.ClientCredentialsRef.Namespace, Name: login.GitHub.ClientCredentialsRef.Name})
+		}
+	}
+	for _, c := range spec.Connect.Clusters {
+		if c.BasicAuthRef != nil {
+			refs = append(refs, types.NamespacedName{Namespace: c.BasicAuthRef.Namespace, Name: c.BasicAuthRef.Name})
+		}
+		if c.TokenRef != nil {
+			refs = append(refs, types.NamespacedName{Namespace: c.TokenRef.Namespace, Name: c.TokenRef.Name})
+		}
+		if c.TLS != nil && c.TLS.Enabled && c.TLS.SecretKeyRef != nil {
+			// Mounted as volume, so it must be in the Console namespace
+			refs = append(refs, types.NamespacedName{Namespace: r.consoleobj.GetNamespace(), Name: c.TLS.SecretKeyRef.Name})
+		}
+	}
+	if r.consoleobj.IsServerTLSEnabled() {
+		refs = append(refs, types.NamespacedName{Namespace: r.consoleobj.GetNamespace(), Name: spec.Server.TLS.SecretRef.Name})
+	}
+	if r.consoleobj.IsServerTLSClientAuthEnabled() {
+		refs = append(refs, types.NamespacedName{Namespace: r.consoleobj.GetNamespace(), Name: spec.Server.TLS.ClientAuth.CARef.Name})
+	}
+	if r.consoleobj.IsKafkaSASLOAuthTLSEnabled() {
+		refs = append(refs, types.NamespacedName{Namespace: r.consoleobj.GetNamespace(), Name: spec.Kafka.SASL.OAuth.TLS.CARef.Name})
+	}
+	if r.consoleobj.IsKafkaSASLKerberosKeytabEnabled() {
+		refs = append(refs, types.NamespacedName{Namespace: r.consoleobj.GetNamespace(), Name: spec.Kafka.SASL.Kerberos.KeytabSecretRef.Name})
+	}
+	// The Cluster CA is generated by the operator, only the Secret referenced by the user is reported
+	if tls := spec.Kafka.TLS; tls != nil && !tls.UseClusterCA && tls.CARef != nil {
+		refs = append(refs, types.NamespacedName{Namespace: r.consoleobj.GetNamespace(), Name: tls.CARef.Name})
+	}
+	return refs
+}
+
+// configMapRefs returns the ConfigMaps referenced by Console, these are in the Console namespace
+func (r *References) configMapRefs() []types.NamespacedName {
+	spec := r.consoleobj.Spec
+	names := []string{}
+	if spec.ConfigTemplateRef != nil {
+		names = append(names, spec.ConfigTemplateRef.Name)
+	}
+	if spec.Enterprise != nil {
+		names = append(names, spec.Enterprise.RBAC.RoleBindingsRef.Name)
+	}
+	if login := spec.Login; login != nil && login.Google != nil && login.Google.Directory != nil {
+		names = append(names, login.Google.Directory.ServiceAccountRef.Name)
+	}
+	if r.consoleobj.IsGitHubLoginEnabled() && spec.Login.GitHub.OrganizationsRef != nil {
+		names = append(names, spec.Login.GitHub.OrganizationsRef.Name)
+	}
+	if spec.Kafka.Protobuf != nil {
+		names = append(names, spec.Kafka.Protobuf.DescriptorConfigMapRef.Name)
+	}
+	if r.consoleobj.IsKafkaSASLKerberosEnabled() {
+		names = append(names, spec.Kafka.SASL.Kerberos.Krb5ConfigMapRef.Name)
+	}
+
+	refs := make([]types.NamespacedName, 0, len(names))
+	for _, name := range names {
+		refs = append(refs, types.NamespacedName{Namespace: r.consoleobj.GetNamespace(), Name: name})
+	}
+	return refs
+}
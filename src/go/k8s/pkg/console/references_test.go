@@ -0,0 +1,74 @@
+// Copyright 2022 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package console_test
+
+import (
+	"context"
+	"testing"
+
+	redpandav1alpha1 "github.com/redpanda-data/redpanda/src/go/k8s/apis/redpanda/v1alpha1"
+	"github.com/redpanda-data/redpanda/src/go/k8s/pkg/console"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestEnsureReferences_UnresolvedRefs(t *testing.T) {
+	require.NoError(t, redpandav1alpha1.AddToScheme(scheme.Scheme))
+	ctx := context.Background()
+
+	consoleobj := testConsole()
+	consoleobj.Spec.LicenseRef = &redpandav1alpha1.LicenseSecretKeyRef{SecretKeyRef: redpandav1alpha1.SecretKeyRef{Name: "license", Namespace: "default"}}
+	consoleobj.Spec.Enterprise = &redpandav1alpha1.Enterprise{
+		RBAC: redpandav1alpha1.EnterpriseRBAC{
+			Enabled:         true,
+			RoleBindingsRef: corev1.LocalObjectReference{Name: "rbac"},
+		},
+	}
+
+	c := fake.NewClientBuilder().Build()
+	require.NoError(t, c.Create(ctx, consoleobj))
+	require.NoError(t, c.Create(ctx, &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "rbac", Namespace: "default"},
+	}))
+
+	ensure := func() []string {
+		require.NoError(t, console.NewReferences(c, consoleobj, ctrl.Log.WithName("test")).Ensure(ctx))
+		actual := &redpandav1alpha1.Console{}
+		require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(consoleobj), actual))
+		return actual.Status.UnresolvedRefs
+	}
+
+	assert.Equal(t, []string{"Secret default/license"}, ensure())
+
+	require.NoError(t, c.Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "license", Namespace: "default"},
+	}))
+	assert.Empty(t, ensure())
+
+	// Credentials of a disabled login provider are not used
+	consoleobj.Spec.Login = &redpandav1alpha1.EnterpriseLogin{
+		Enabled:      true,
+		JWTSecretRef: redpandav1alpha1.SecretKeyRef{Name: "license", Namespace: "default"},
+		Google: &redpandav1alpha1.EnterpriseLoginGoogle{
+			Enabled:              false,
+			ClientCredentialsRef: redpandav1alpha1.NamespaceNameRef{Name: "google", Namespace: "default"},
+		},
+	}
+	assert.Empty(t, ensure())
+
+	consoleobj.Spec.Login.Google.Enabled = true
+	assert.Equal(t, []string{"Secret default/google"}, ensure())
+}
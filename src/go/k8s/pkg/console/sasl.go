@@ -23,12 +23,13 @@ import (
 // KafkaSA is a Console resource
 type KafkaSA struct {
 	client.Client
-	scheme        *runtime.Scheme
-	consoleobj    *redpandav1alpha1.Console
-	clusterobj    *redpandav1alpha1.Cluster
-	clusterDomain string
-	adminAPI      adminutils.AdminAPIClientFactory
-	log           logr.Logger
+	scheme          *runtime.Scheme
+	consoleobj      *redpandav1alpha1.Console
+	clusterobj      *redpandav1alpha1.Cluster
+	clusterDomain   string
+	adminAPI        adminutils.AdminAPIClientFactory
+	finalizerPrefix string
+	log             logr.Logger
 }
 
 // NewKafkaSA instantiates a new KafkaSA
@@ -39,27 +40,21 @@ func NewKafkaSA(
 	clusterobj *redpandav1alpha1.Cluster,
 	clusterDomain string,
 	adminAPI adminutils.AdminAPIClientFactory,
+	finalizerPrefix string,
 	log logr.Logger,
 ) *KafkaSA {
 	return &KafkaSA{
-		Client:        cl,
-		scheme:        scheme,
-		consoleobj:    consoleobj,
-		clusterobj:    clusterobj,
-		clusterDomain: clusterDomain,
-		adminAPI:      adminAPI,
-		log:           log,
+		Client:          cl,
+		scheme:          scheme,
+		consoleobj:      consoleobj,
+		clusterobj:      clusterobj,
+		clusterDomain:   clusterDomain,
+		adminAPI:        adminAPI,
+		finalizerPrefix: finalizerPrefix,
+		log:             log,
 	}
 }
 
-var (
-	// ConsoleSAFinalizer is the finalizer for deleting Service Account
-	ConsoleSAFinalizer = "consoles.redpanda.vectorized.io/service-account"
-
-	// ConsoleACLFinalizer is the finalizer for deleting ACLs
-	ConsoleACLFinalizer = "consoles.redpanda.vectorized.io/acl"
-)
-
 type (
 	// KafkaAdminClient contains functions from kadm.Client functions used by KafkaSA
 	KafkaAdminClient interface {
@@ -83,7 +78,13 @@ func KafkaSASecretKey(console *redpandav1alpha1.Console) types.NamespacedName {
 
 // Ensure implements Resource interface
 func (k *KafkaSA) Ensure(ctx context.Context) error {
-	su := resources.NewSuperUsers(k.Client, k.consoleobj, k.scheme, GenerateSASLUsername(k.consoleobj), resources.ConsoleSuffix, k.log)
+	// Existing credentials are used, no SCRAM user to create
+	if k.consoleobj.IsExternalSASLEnabled() {
+		return nil
+	}
+
+	su := resources.NewSuperUsers(k.Client, k.consoleobj, k.scheme, GenerateSASLUsername(k.consoleobj), resources.ConsoleSuffix, k.log).
+		WithOwnerReference(!k.consoleobj.Spec.DisableOwnerReferences)
 	if err := su.Ensure(ctx); err != nil {
 		return fmt.Errorf("ensuring sasl user secret: %w", err)
 	}
@@ -115,8 +116,8 @@ func (k *KafkaSA) Ensure(ctx context.Context) error {
 		}
 	}
 
-	if !controllerutil.ContainsFinalizer(k.consoleobj, ConsoleSAFinalizer) {
-		controllerutil.AddFinalizer(k.consoleobj, ConsoleSAFinalizer)
+	if finalizer := Finalizer(k.finalizerPrefix, ConsoleSAFinalizer); !controllerutil.ContainsFinalizer(k.consoleobj, finalizer) {
+		controllerutil.AddFinalizer(k.consoleobj, finalizer)
 		if err := k.Update(ctx, k.consoleobj); err != nil {
 			return err
 		}
@@ -134,7 +135,7 @@ func (k *KafkaSA) Key() (nsn types.NamespacedName) {
 
 // Cleanup implements ManagedResource interface
 func (k *KafkaSA) Cleanup(ctx context.Context) error {
-	if !controllerutil.ContainsFinalizer(k.consoleobj, ConsoleSAFinalizer) {
+	if !containsFinalizer(k.consoleobj, k.finalizerPrefix, ConsoleSAFinalizer) {
 		return nil
 	}
 
@@ -146,18 +147,19 @@ func (k *KafkaSA) Cleanup(ctx context.Context) error {
 	if err := adminAPI.DeleteUser(ctx, GenerateSASLUsername(k.consoleobj)); err != nil {
 		return err
 	}
-	controllerutil.RemoveFinalizer(k.consoleobj, ConsoleSAFinalizer)
+	removeFinalizer(k.consoleobj, k.finalizerPrefix, ConsoleSAFinalizer)
 	return k.Update(ctx, k.consoleobj)
 }
 
 // KafkaACL is a Console resource
 type KafkaACL struct {
 	client.Client
-	scheme     *runtime.Scheme
-	consoleobj *redpandav1alpha1.Console
-	clusterobj *redpandav1alpha1.Cluster
-	kafkaAdmin KafkaAdminClientFactory
-	log        logr.Logger
+	scheme          *runtime.Scheme
+	consoleobj      *redpandav1alpha1.Console
+	clusterobj      *redpandav1alpha1.Cluster
+	kafkaAdmin      KafkaAdminClientFactory
+	finalizerPrefix string
+	log             logr.Logger
 }
 
 // NewKafkaACL instantiates a new KafkaACL
@@ -167,103 +169,212 @@ func NewKafkaACL(
 	consoleobj *redpandav1alpha1.Console,
 	clusterobj *redpandav1alpha1.Cluster,
 	kafkaAdmin KafkaAdminClientFactory,
+	finalizerPrefix string,
 	log logr.Logger,
 ) *KafkaACL {
 	return &KafkaACL{
-		Client:     cl,
-		scheme:     scheme,
-		consoleobj: consoleobj,
-		clusterobj: clusterobj,
-		kafkaAdmin: kafkaAdmin,
-		log:        log,
+		Client:          cl,
+		scheme:          scheme,
+		consoleobj:      consoleobj,
+		clusterobj:      clusterobj,
+		kafkaAdmin:      kafkaAdmin,
+		finalizerPrefix: finalizerPrefix,
+		log:             log,
 	}
 }
 
 // Ensure implements Resource interface
+// ACLs applied for a previous principal or consumer group prefix are deleted before the desired ACLs are created
 func (k *KafkaACL) Ensure(ctx context.Context) error {
-	// Build ACL for console SASL user to access everything
-	b := kadm.NewACLs().
-		Allow(GenerateSASLUsername(k.consoleobj)).
-		Topics("*").Groups("*").Clusters().Operations(kadm.OpAll).
-		ResourcePatternType(kadm.ACLPatternLiteral)
-	if err := b.ValidateCreate(); err != nil {
-		return fmt.Errorf("validating create ACLs: %w", err)
+	applied := k.appliedACLs()
+
+	// ACLs of existing credentials are not managed by the operator unless requested
+	if k.consoleobj.IsExternalSASLEnabled() && !k.consoleobj.IsExternalSASLManageACLsOnly() {
+		if applied == nil {
+			return nil
+		}
+		if err := k.deleteACLs(ctx, *applied); err != nil {
+			return err
+		}
+		k.consoleobj.Status.KafkaACLs = nil
+		if err := UpdateStatus(ctx, k.Client, k.consoleobj); err != nil {
+			return err
+		}
+		removeFinalizer(k.consoleobj, k.finalizerPrefix, ConsoleACLFinalizer)
+		return k.Update(ctx, k.consoleobj)
 	}
-	b.PrefixUserExcept()
 
-	kadmclient, err := k.kafkaAdmin(ctx, k.Client, k.clusterobj)
+	desired, err := k.desiredACLs()
 	if err != nil {
-		return fmt.Errorf("creating kafka admin client: %w", err)
+		return err
+	}
+	if applied != nil && *applied != desired {
+		if err := k.deleteACLs(ctx, *applied); err != nil {
+			return err
+		}
 	}
 
-	results, err := kadmclient.CreateACLs(ctx, b)
+	builders := aclBuilders(desired)
+	for _, b := range builders {
+		if err := b.ValidateCreate(); err != nil {
+			return fmt.Errorf("validating create ACLs: %w", err)
+		}
+		b.PrefixUserExcept()
+	}
+
+	kadmclient, err := k.kafkaAdmin(ctx, k.Client, k.clusterobj)
 	if err != nil {
-		return fmt.Errorf("creating kafka ACLs: %w", err)
+		return fmt.Errorf("creating kafka admin client: %w", err)
 	}
-	// CreateACLs returns no error, check results
+
 	var errList []error
-	for _, r := range results {
-		if r.Err != nil {
-			errList = append(errList, r.Err)
+	for _, b := range builders {
+		results, err := kadmclient.CreateACLs(ctx, b)
+		if err != nil {
+			return fmt.Errorf("creating kafka ACLs: %w", err)
+		}
+		// CreateACLs returns no error, check results
+		for _, r := range results {
+			if r.Err != nil {
+				errList = append(errList, r.Err)
+			}
 		}
 	}
 	if len(errList) > 0 {
 		return fmt.Errorf("creating kafka ACLs: %w", kerrors.NewAggregate(errList))
 	}
 
-	if !controllerutil.ContainsFinalizer(k.consoleobj, ConsoleACLFinalizer) {
-		controllerutil.AddFinalizer(k.consoleobj, ConsoleACLFinalizer)
+	if finalizer := Finalizer(k.finalizerPrefix, ConsoleACLFinalizer); !controllerutil.ContainsFinalizer(k.consoleobj, finalizer) {
+		controllerutil.AddFinalizer(k.consoleobj, finalizer)
 		if err := k.Update(ctx, k.consoleobj); err != nil {
 			return err
 		}
 	}
 
+	// Record the applied ACLs right away, the next reconcile must be able to delete them even if this one fails later
+	if k.consoleobj.Status.KafkaACLs == nil || *k.consoleobj.Status.KafkaACLs != desired {
+		k.consoleobj.Status.KafkaACLs = &desired
+		return UpdateStatus(ctx, k.Client, k.consoleobj)
+	}
 	return nil
 }
 
-// Key implements Resource interface
-// But this is not a K8s resource, not implemented
-// In the future we might track Kafka ACLs via CR
-func (k *KafkaACL) Key() (nsn types.NamespacedName) {
-	return nsn
+// desiredACLs returns the inputs of the ACLs of the Console SASL user
+func (k *KafkaACL) desiredACLs() (redpandav1alpha1.ConsoleKafkaACLs, error) {
+	acls := redpandav1alpha1.ConsoleKafkaACLs{
+		Principal:           GenerateSASLUsername(k.consoleobj),
+		ConsumerGroupPrefix: k.consoleobj.Spec.Kafka.ConsumerGroupPrefix,
+	}
+	if k.consoleobj.IsExternalSASLManageACLsOnly() {
+		acls.Principal = k.consoleobj.Spec.Kafka.SASL.ExistingPrincipal
+		if acls.Principal == "" {
+			return acls, fmt.Errorf("existing principal must be set if only ACLs are managed") //nolint:goerr113 // no need to declare new error type
+		}
+	}
+	return acls, nil
 }
 
-// Cleanup implements ManagedResource interface
-func (k *KafkaACL) Cleanup(ctx context.Context) error {
-	if !controllerutil.ContainsFinalizer(k.consoleobj, ConsoleACLFinalizer) {
+// appliedACLs returns the inputs of the ACLs applied by a previous reconcile, nil if none were applied
+func (k *KafkaACL) appliedACLs() *redpandav1alpha1.ConsoleKafkaACLs {
+	if k.consoleobj.Status.KafkaACLs != nil {
+		applied := *k.consoleobj.Status.KafkaACLs
+		return &applied
+	}
+	if !containsFinalizer(k.consoleobj, k.finalizerPrefix, ConsoleACLFinalizer) {
 		return nil
 	}
+	// ACLs were applied before they were recorded in status, these were granted to the SCRAM user for all consumer groups
+	return &redpandav1alpha1.ConsoleKafkaACLs{Principal: GenerateSASLUsername(k.consoleobj)}
+}
 
-	// Build ACL for console SASL user to access everything
-	b := kadm.NewACLs().
-		Allow(GenerateSASLUsername(k.consoleobj)).AllowHosts().
-		Topics("*").Groups("*").Clusters().Operations(kadm.OpAll).
-		ResourcePatternType(kadm.ACLPatternLiteral)
-	if err := b.ValidateCreate(); err != nil {
-		return fmt.Errorf("validating create ACLs: %w", err)
+// aclBuilders returns the ACLs for the given inputs
+// Consumer group ACLs are scoped to ConsumerGroupPrefix if set
+func aclBuilders(acls redpandav1alpha1.ConsoleKafkaACLs) []*kadm.ACLBuilder {
+	if acls.ConsumerGroupPrefix == "" {
+		// Build ACL for console SASL user to access everything
+		return []*kadm.ACLBuilder{
+			kadm.NewACLs().
+				Allow(acls.Principal).
+				Topics("*").Groups("*").Clusters().Operations(kadm.OpAll).
+				ResourcePatternType(kadm.ACLPatternLiteral),
+		}
+	}
+	return []*kadm.ACLBuilder{
+		kadm.NewACLs().
+			Allow(acls.Principal).
+			Topics("*").Clusters().Operations(kadm.OpAll).
+			ResourcePatternType(kadm.ACLPatternLiteral),
+		// Prefixed pattern matches all groups starting with the prefix, i.e. "<prefix>*"
+		kadm.NewACLs().
+			Allow(acls.Principal).
+			Groups(acls.ConsumerGroupPrefix).Operations(kadm.OpAll).
+			ResourcePatternType(kadm.ACLPatternPrefixed),
+	}
+}
+
+// deleteACLs deletes the ACLs for the given inputs
+func (k *KafkaACL) deleteACLs(ctx context.Context, acls ...redpandav1alpha1.ConsoleKafkaACLs) error {
+	var builders []*kadm.ACLBuilder
+	for _, a := range acls {
+		builders = append(builders, aclBuilders(a)...)
+	}
+	for _, b := range builders {
+		b.AllowHosts()
+		if err := b.ValidateCreate(); err != nil {
+			return fmt.Errorf("validating create ACLs: %w", err)
+		}
+		b.PrefixUserExcept()
 	}
-	b.PrefixUserExcept()
 
 	kadmclient, err := k.kafkaAdmin(ctx, k.Client, k.clusterobj)
 	if err != nil {
 		return fmt.Errorf("creating kafka admin client: %w", err)
 	}
 
-	results, err := kadmclient.DeleteACLs(ctx, b)
-	if err != nil {
-		return fmt.Errorf("deleting kafka ACLs: %w", err)
-	}
-	// DeleteACLs returns no error, check results
 	var errList []error
-	for _, r := range results {
-		if r.Err != nil {
-			errList = append(errList, r.Err)
+	for _, b := range builders {
+		results, err := kadmclient.DeleteACLs(ctx, b)
+		if err != nil {
+			return fmt.Errorf("deleting kafka ACLs: %w", err)
+		}
+		// DeleteACLs returns no error, check results
+		for _, r := range results {
+			if r.Err != nil {
+				errList = append(errList, r.Err)
+			}
 		}
 	}
 	if len(errList) > 0 {
 		return fmt.Errorf("deleting kafka ACLs: %w", kerrors.NewAggregate(errList))
 	}
+	return nil
+}
+
+// Key implements Resource interface
+// But this is not a K8s resource, not implemented
+// In the future we might track Kafka ACLs via CR
+func (k *KafkaACL) Key() (nsn types.NamespacedName) {
+	return nsn
+}
+
+// Cleanup implements ManagedResource interface
+// Both the applied ACLs and the ACLs of the current spec are deleted, the spec might have changed since the last reconcile
+func (k *KafkaACL) Cleanup(ctx context.Context) error {
+	if !containsFinalizer(k.consoleobj, k.finalizerPrefix, ConsoleACLFinalizer) {
+		return nil
+	}
+
+	var acls []redpandav1alpha1.ConsoleKafkaACLs
+	if applied := k.appliedACLs(); applied != nil {
+		acls = append(acls, *applied)
+	}
+	if desired, err := k.desiredACLs(); err == nil && (len(acls) == 0 || acls[0] != desired) {
+		acls = append(acls, desired)
+	}
+	if err := k.deleteACLs(ctx, acls...); err != nil {
+		return err
+	}
 
-	controllerutil.RemoveFinalizer(k.consoleobj, ConsoleACLFinalizer)
+	removeFinalizer(k.consoleobj, k.finalizerPrefix, ConsoleACLFinalizer)
 	return k.Update(ctx, k.consoleobj)
 }
@@ -0,0 +1,547 @@
+// Copyright 2022 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package console_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	redpandav1alpha1 "github.com/redpanda-data/redpanda/src/go/k8s/apis/redpanda/v1alpha1"
+	adminutils "github.com/redpanda-data/redpanda/src/go/k8s/pkg/admin"
+	"github.com/redpanda-data/redpanda/src/go/k8s/pkg/console"
+	"github.com/redpanda-data/redpanda/src/go/k8s/pkg/resources/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twmb/franz-go/pkg/kadm"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestKafkaSA_ExternalSASLPlain(t *testing.T) {
+	require.NoError(t, redpandav1alpha1.AddToScheme(scheme.Scheme))
+	ctx := context.Background()
+	log := ctrl.Log.WithName("test")
+
+	consoleobj := testConsole()
+	consoleobj.Spec.Kafka.SASL = &redpandav1alpha1.KafkaSASL{
+		Mechanism:      redpandav1alpha1.KafkaSASLMechanismPlain,
+		CredentialsRef: redpandav1alpha1.NamespaceNameRef{Name: "kafka-plain", Namespace: "default"},
+	}
+	cluster := testCluster()
+	cluster.Spec.EnableSASL = true
+
+	c := fake.NewClientBuilder().Build()
+	require.NoError(t, c.Create(ctx, consoleobj))
+	require.NoError(t, c.Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "kafka-plain", Namespace: "default"},
+		Data: map[string][]byte{
+			corev1.BasicAuthUsernameKey: []byte("external"),
+			corev1.BasicAuthPasswordKey: []byte("secret"),
+		},
+	}))
+
+	adminAPICalled := false
+	adminAPI := func(
+		context.Context, client.Reader, *redpandav1alpha1.Cluster, string, types.AdminTLSConfigProvider, ...int32,
+	) (adminutils.AdminAPIClient, error) {
+		adminAPICalled = true
+		return nil, nil
+	}
+	kafkaAdminCalled := false
+	kafkaAdmin := func(context.Context, client.Client, *redpandav1alpha1.Cluster) (console.KafkaAdminClient, error) {
+		kafkaAdminCalled = true
+		return nil, nil
+	}
+
+	require.NoError(t, console.NewKafkaSA(c, scheme.Scheme, consoleobj, cluster, "cluster.local", adminAPI, console.DefaultFinalizerPrefix, log).Ensure(ctx))
+	require.NoError(t, console.NewKafkaACL(c, scheme.Scheme, consoleobj, cluster, kafkaAdmin, console.DefaultFinalizerPrefix, log).Ensure(ctx))
+	assert.False(t, adminAPICalled, "SCRAM user should not be created")
+	assert.False(t, kafkaAdminCalled, "ACLs should not be created")
+	assert.True(t, apierrors.IsNotFound(c.Get(ctx, console.KafkaSASecretKey(consoleobj), &corev1.Secret{})))
+	assert.Empty(t, consoleobj.GetFinalizers())
+
+	cc := ensureConfig(t, c, consoleobj, cluster)
+	assert.True(t, cc.Kafka.SASL.Enabled)
+	assert.Equal(t, "PLAIN", cc.Kafka.SASL.Mechanism)
+	assert.Equal(t, "external", cc.Kafka.SASL.Username)
+	assert.Equal(t, "secret", cc.Kafka.SASL.Password)
+}
+
+func TestGenerateConfig_ExternalSASLOAuthRefresh(t *testing.T) {
+	ctx := context.Background()
+	consoleobj := testConsole()
+	consoleobj.Spec.Kafka.SASL = &redpandav1alpha1.KafkaSASL{
+		Mechanism:      redpandav1alpha1.KafkaSASLMechanismOAuthBearer,
+		CredentialsRef: redpandav1alpha1.NamespaceNameRef{Name: "kafka-oauth", Namespace: "default"},
+		OAuth: &redpandav1alpha1.KafkaSASLOAuth{
+			RefreshBeforeExpiry:     &metav1.Duration{Duration: 2 * time.Minute},
+			ReauthenticationEnabled: true,
+		},
+	}
+
+	c := fake.NewClientBuilder().Build()
+	require.NoError(t, c.Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "kafka-oauth", Namespace: "default"},
+		Data:       map[string][]byte{console.KafkaSASLOAuthTokenKey: []byte("bearer-token")},
+	}))
+
+	ensureConfigUnsupported(t, c, consoleobj, testCluster(), "refreshBeforeExpiry", "reauthenticationEnabled")
+
+	// Token without refresh settings is supported
+	consoleobj.Spec.Kafka.SASL.OAuth = nil
+	cc := ensureConfig(t, c, consoleobj, testCluster())
+	assert.True(t, cc.Kafka.SASL.Enabled)
+	assert.Equal(t, "OAUTHBEARER", cc.Kafka.SASL.Mechanism)
+	assert.Equal(t, "bearer-token", cc.Kafka.SASL.OAUth.Token)
+}
+
+func TestGenerateConfig_ExternalSASLAWSMSKIAM(t *testing.T) {
+	require.NoError(t, redpandav1alpha1.AddToScheme(scheme.Scheme))
+	ctx := context.Background()
+	log := ctrl.Log.WithName("test")
+
+	consoleobj := testConsole()
+	consoleobj.Spec.Kafka.SASL = &redpandav1alpha1.KafkaSASL{
+		Mechanism:      redpandav1alpha1.KafkaSASLMechanismAWSMSKIAM,
+		CredentialsRef: redpandav1alpha1.NamespaceNameRef{Name: "kafka-msk", Namespace: "default"},
+		AWSMSKIAM: &redpandav1alpha1.KafkaSASLAWSMSKIAM{
+			Region:  "us-east-1",
+			RoleARN: "arn:aws:iam::123456789012:role/console",
+		},
+		// Ignored, access is granted by IAM policies
+		ManageACLsOnly:    true,
+		ExistingPrincipal: "console",
+	}
+	cluster := testCluster()
+
+	c := fake.NewClientBuilder().Build()
+	require.NoError(t, c.Create(ctx, consoleobj))
+	require.NoError(t, c.Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "kafka-msk", Namespace: "default"},
+		Data: map[string][]byte{
+			console.KafkaSASLAWSAccessKeyKey: []byte("access"),
+			console.KafkaSASLAWSSecretKeyKey: []byte("secret"),
+		},
+	}))
+
+	adminAPICalled := false
+	adminAPI := func(
+		context.Context, client.Reader, *redpandav1alpha1.Cluster, string, types.AdminTLSConfigProvider, ...int32,
+	) (adminutils.AdminAPIClient, error) {
+		adminAPICalled = true
+		return nil, nil
+	}
+	kafkaAdminCalled := false
+	kafkaAdmin := func(context.Context, client.Client, *redpandav1alpha1.Cluster) (console.KafkaAdminClient, error) {
+		kafkaAdminCalled = true
+		return nil, nil
+	}
+
+	require.NoError(t, console.NewKafkaSA(c, scheme.Scheme, consoleobj, cluster, "cluster.local", adminAPI, console.DefaultFinalizerPrefix, log).Ensure(ctx))
+	require.NoError(t, console.NewKafkaACL(c, scheme.Scheme, consoleobj, cluster, kafkaAdmin, console.DefaultFinalizerPrefix, log).Ensure(ctx))
+	assert.False(t, adminAPICalled, "SCRAM user should not be created")
+	assert.False(t, kafkaAdminCalled, "ACLs should not be created")
+
+	ensureConfigUnsupported(t, c, consoleobj, cluster, "region", "roleArn")
+
+	// Static credentials are supported
+	consoleobj.Spec.Kafka.SASL.AWSMSKIAM = nil
+	cc := ensureConfig(t, c, consoleobj, cluster)
+	assert.True(t, cc.Kafka.SASL.Enabled)
+	assert.Equal(t, "AWS_MSK_IAM", cc.Kafka.SASL.Mechanism)
+	assert.Equal(t, "access", cc.Kafka.SASL.AWSMskIam.AccessKey)
+	assert.Equal(t, "secret", cc.Kafka.SASL.AWSMskIam.SecretKey)
+	assert.Empty(t, cc.Kafka.SASL.AWSMskIam.SessionToken)
+	assert.Empty(t, cc.Kafka.SASL.Username)
+}
+
+func TestEnsureDeployment_ExternalSASLOAuthTLS(t *testing.T) {
+	require.NoError(t, redpandav1alpha1.AddToScheme(scheme.Scheme))
+	ctx := context.Background()
+	consoleobj := testConsole()
+	consoleobj.Spec.Kafka.SASL = &redpandav1alpha1.KafkaSASL{
+		Mechanism:      redpandav1alpha1.KafkaSASLMechanismOAuthBearer,
+		CredentialsRef: redpandav1alpha1.NamespaceNameRef{Name: "kafka-oauth", Namespace: "default"},
+		OAuth: &redpandav1alpha1.KafkaSASLOAuth{
+			TLS: &redpandav1alpha1.KafkaSASLOAuthTLS{CARef: corev1.LocalObjectReference{Name: "oauth-ca"}},
+		},
+	}
+	cluster := testCluster()
+
+	c := fake.NewClientBuilder().Build()
+	require.NoError(t, c.Create(ctx, consoleobj))
+	require.NoError(t, c.Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "kafka-oauth", Namespace: "default"},
+		Data:       map[string][]byte{console.KafkaSASLOAuthTokenKey: []byte("bearer-token")},
+	}))
+
+	ensureConfigUnsupported(t, c, consoleobj, cluster, "tls")
+
+	// The Deployment keeps the previous config
+	consoleobj.Status.ConfigMapRef = &corev1.ObjectReference{Kind: "ConfigMap", Namespace: "default", Name: "console-previous"}
+	d := console.NewDeployment(c, scheme.Scheme, consoleobj, cluster, console.NewStore(c), ctrl.Log.WithName("test"))
+	require.NoError(t, d.Ensure(ctx))
+	actual := &appsv1.Deployment{}
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(consoleobj), actual))
+
+	secrets := map[string]string{}
+	for _, v := range actual.Spec.Template.Spec.Volumes {
+		if v.Secret != nil {
+			secrets[v.Name] = v.Secret.SecretName
+		}
+	}
+	assert.Equal(t, "oauth-ca", secrets["tls-oauth-ca"])
+
+	mounts := map[string]string{}
+	for _, m := range actual.Spec.Template.Spec.Containers[0].VolumeMounts {
+		mounts[m.Name] = m.MountPath
+	}
+	assert.Equal(t, "/etc/console/tls/oauth-ca", mounts["tls-oauth-ca"])
+}
+
+func TestGenerateConfig_ExternalSASLCustomKeys(t *testing.T) {
+	ctx := context.Background()
+	consoleobj := testConsole()
+	consoleobj.Spec.Kafka.SASL = &redpandav1alpha1.KafkaSASL{
+		Mechanism:      redpandav1alpha1.KafkaSASLMechanismPlain,
+		CredentialsRef: redpandav1alpha1.NamespaceNameRef{Name: "kafka-plain", Namespace: "default"},
+		SecretRef:      &redpandav1alpha1.KafkaSASLSecretRef{UsernameKey: "user", PasswordKey: "pass"},
+	}
+
+	c := fake.NewClientBuilder().Build()
+	require.NoError(t, c.Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "kafka-plain", Namespace: "default"},
+		Data: map[string][]byte{
+			"user": []byte("external"),
+			"pass": []byte("secret"),
+		},
+	}))
+
+	cc := ensureConfig(t, c, consoleobj, testCluster())
+	assert.True(t, cc.Kafka.SASL.Enabled)
+	assert.Equal(t, "external", cc.Kafka.SASL.Username)
+	assert.Equal(t, "secret", cc.Kafka.SASL.Password)
+}
+
+type recordingKafkaAdmin struct {
+	created []*kadm.ACLBuilder
+	deleted []*kadm.ACLBuilder
+}
+
+func (r *recordingKafkaAdmin) CreateACLs(_ context.Context, b *kadm.ACLBuilder) (kadm.CreateACLsResults, error) {
+	r.created = append(r.created, b)
+	return nil, nil
+}
+
+func (r *recordingKafkaAdmin) DeleteACLs(_ context.Context, b *kadm.ACLBuilder) (kadm.DeleteACLsResults, error) {
+	r.deleted = append(r.deleted, b)
+	return nil, nil
+}
+
+func TestKafkaACL_ConsumerGroupPrefix(t *testing.T) {
+	require.NoError(t, redpandav1alpha1.AddToScheme(scheme.Scheme))
+	ctx := context.Background()
+
+	consoleobj := testConsole()
+	consoleobj.Spec.Kafka.ConsumerGroupPrefix = "console-"
+
+	c := fake.NewClientBuilder().Build()
+	require.NoError(t, c.Create(ctx, consoleobj))
+
+	admin := &recordingKafkaAdmin{}
+	kafkaAdmin := func(context.Context, client.Client, *redpandav1alpha1.Cluster) (console.KafkaAdminClient, error) {
+		return admin, nil
+	}
+	require.NoError(t, console.NewKafkaACL(c, scheme.Scheme, consoleobj, testCluster(), kafkaAdmin, console.DefaultFinalizerPrefix, ctrl.Log.WithName("test")).Ensure(ctx))
+
+	user := console.GenerateSASLUsername(consoleobj)
+	groups := kadm.NewACLs().
+		Allow(user).
+		Groups("console-").Operations(kadm.OpAll).
+		ResourcePatternType(kadm.ACLPatternPrefixed)
+	groups.PrefixUserExcept()
+	require.Len(t, admin.created, 2)
+	assert.Equal(t, groups, admin.created[1])
+
+	// Topic and cluster ACLs are not scoped by the prefix
+	others := kadm.NewACLs().
+		Allow(user).
+		Topics("*").Clusters().Operations(kadm.OpAll).
+		ResourcePatternType(kadm.ACLPatternLiteral)
+	others.PrefixUserExcept()
+	assert.Equal(t, others, admin.created[0])
+}
+
+func TestKafkaACL_ConsumerGroupPrefixChanged(t *testing.T) {
+	require.NoError(t, redpandav1alpha1.AddToScheme(scheme.Scheme))
+	ctx := context.Background()
+	log := ctrl.Log.WithName("test")
+
+	consoleobj := testConsole()
+	c := fake.NewClientBuilder().Build()
+	require.NoError(t, c.Create(ctx, consoleobj))
+
+	admin := &recordingKafkaAdmin{}
+	kafkaAdmin := func(context.Context, client.Client, *redpandav1alpha1.Cluster) (console.KafkaAdminClient, error) {
+		return admin, nil
+	}
+	require.NoError(t, console.NewKafkaACL(c, scheme.Scheme, consoleobj, testCluster(), kafkaAdmin, console.DefaultFinalizerPrefix, log).Ensure(ctx))
+	user := console.GenerateSASLUsername(consoleobj)
+	require.NotNil(t, consoleobj.Status.KafkaACLs)
+	assert.Equal(t, redpandav1alpha1.ConsoleKafkaACLs{Principal: user}, *consoleobj.Status.KafkaACLs)
+	assert.Empty(t, admin.deleted)
+
+	admin.created = nil
+	consoleobj.Spec.Kafka.ConsumerGroupPrefix = "console-"
+	require.NoError(t, console.NewKafkaACL(c, scheme.Scheme, consoleobj, testCluster(), kafkaAdmin, console.DefaultFinalizerPrefix, log).Ensure(ctx))
+
+	// The ACL allowing all consumer groups is deleted
+	stale := kadm.NewACLs().
+		Allow(user).
+		Topics("*").Groups("*").Clusters().Operations(kadm.OpAll).
+		ResourcePatternType(kadm.ACLPatternLiteral).
+		AllowHosts()
+	stale.PrefixUserExcept()
+	require.Len(t, admin.deleted, 1)
+	assert.Equal(t, stale, admin.deleted[0])
+
+	groups := kadm.NewACLs().
+		Allow(user).
+		Groups("console-").Operations(kadm.OpAll).
+		ResourcePatternType(kadm.ACLPatternPrefixed)
+	groups.PrefixUserExcept()
+	require.Len(t, admin.created, 2)
+	assert.Equal(t, groups, admin.created[1])
+
+	var latest redpandav1alpha1.Console
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(consoleobj), &latest))
+	require.NotNil(t, latest.Status.KafkaACLs)
+	assert.Equal(t, redpandav1alpha1.ConsoleKafkaACLs{Principal: user, ConsumerGroupPrefix: "console-"}, *latest.Status.KafkaACLs)
+
+	// Unchanged spec doesn't delete anything
+	admin.deleted = nil
+	require.NoError(t, console.NewKafkaACL(c, scheme.Scheme, consoleobj, testCluster(), kafkaAdmin, console.DefaultFinalizerPrefix, log).Ensure(ctx))
+	assert.Empty(t, admin.deleted)
+
+	// ACLs of earlier specs are deleted on Cleanup
+	admin.deleted = nil
+	consoleobj.Spec.Kafka.ConsumerGroupPrefix = "other-"
+	require.NoError(t, console.NewKafkaACL(c, scheme.Scheme, consoleobj, testCluster(), kafkaAdmin, console.DefaultFinalizerPrefix, log).Cleanup(ctx))
+	var expected []*kadm.ACLBuilder
+	for _, prefix := range []string{"console-", "other-"} {
+		expected = append(expected,
+			kadm.NewACLs().
+				Allow(user).
+				Topics("*").Clusters().Operations(kadm.OpAll).
+				ResourcePatternType(kadm.ACLPatternLiteral).
+				AllowHosts(),
+			kadm.NewACLs().
+				Allow(user).
+				Groups(prefix).Operations(kadm.OpAll).
+				ResourcePatternType(kadm.ACLPatternPrefixed).
+				AllowHosts(),
+		)
+	}
+	for _, b := range expected {
+		b.PrefixUserExcept()
+	}
+	assert.Equal(t, expected, admin.deleted)
+	assert.Empty(t, consoleobj.GetFinalizers())
+}
+
+func TestKafkaACL_ExternalSASLManageACLsOnly(t *testing.T) {
+	require.NoError(t, redpandav1alpha1.AddToScheme(scheme.Scheme))
+	ctx := context.Background()
+	log := ctrl.Log.WithName("test")
+
+	consoleobj := testConsole()
+	consoleobj.Spec.Kafka.SASL = &redpandav1alpha1.KafkaSASL{
+		Mechanism:         redpandav1alpha1.KafkaSASLMechanismPlain,
+		CredentialsRef:    redpandav1alpha1.NamespaceNameRef{Name: "kafka-plain", Namespace: "default"},
+		ManageACLsOnly:    true,
+		ExistingPrincipal: "external",
+	}
+	cluster := testCluster()
+	cluster.Spec.EnableSASL = true
+
+	c := fake.NewClientBuilder().Build()
+	require.NoError(t, c.Create(ctx, consoleobj))
+
+	adminAPICalled := false
+	adminAPI := func(
+		context.Context, client.Reader, *redpandav1alpha1.Cluster, string, types.AdminTLSConfigProvider, ...int32,
+	) (adminutils.AdminAPIClient, error) {
+		adminAPICalled = true
+		return nil, nil
+	}
+	admin := &recordingKafkaAdmin{}
+	kafkaAdmin := func(context.Context, client.Client, *redpandav1alpha1.Cluster) (console.KafkaAdminClient, error) {
+		return admin, nil
+	}
+
+	require.NoError(t, console.NewKafkaSA(c, scheme.Scheme, consoleobj, cluster, "cluster.local", adminAPI, console.DefaultFinalizerPrefix, log).Ensure(ctx))
+	require.NoError(t, console.NewKafkaACL(c, scheme.Scheme, consoleobj, cluster, kafkaAdmin, console.DefaultFinalizerPrefix, log).Ensure(ctx))
+	assert.False(t, adminAPICalled, "SCRAM user should not be created")
+	assert.True(t, apierrors.IsNotFound(c.Get(ctx, console.KafkaSASecretKey(consoleobj), &corev1.Secret{})))
+	assert.Equal(t, []string{console.ConsoleACLFinalizer}, consoleobj.GetFinalizers())
+
+	expected := kadm.NewACLs().
+		Allow("external").
+		Topics("*").Groups("*").Clusters().Operations(kadm.OpAll).
+		ResourcePatternType(kadm.ACLPatternLiteral)
+	expected.PrefixUserExcept()
+	require.Len(t, admin.created, 1)
+	assert.Equal(t, expected, admin.created[0])
+}
+
+func TestKafkaACL_ExistingPrincipalChanged(t *testing.T) {
+	require.NoError(t, redpandav1alpha1.AddToScheme(scheme.Scheme))
+	ctx := context.Background()
+	log := ctrl.Log.WithName("test")
+
+	consoleobj := testConsole()
+	c := fake.NewClientBuilder().Build()
+	require.NoError(t, c.Create(ctx, consoleobj))
+
+	admin := &recordingKafkaAdmin{}
+	kafkaAdmin := func(context.Context, client.Client, *redpandav1alpha1.Cluster) (console.KafkaAdminClient, error) {
+		return admin, nil
+	}
+	aclsOf := func(user string) *kadm.ACLBuilder {
+		b := kadm.NewACLs().
+			Allow(user).
+			Topics("*").Groups("*").Clusters().Operations(kadm.OpAll).
+			ResourcePatternType(kadm.ACLPatternLiteral).
+			AllowHosts()
+		b.PrefixUserExcept()
+		return b
+	}
+	require.NoError(t, console.NewKafkaACL(c, scheme.Scheme, consoleobj, testCluster(), kafkaAdmin, console.DefaultFinalizerPrefix, log).Ensure(ctx))
+
+	// Switching from the SCRAM user to an existing principal deletes the ACLs of the SCRAM user
+	consoleobj.Spec.Kafka.SASL = &redpandav1alpha1.KafkaSASL{
+		Mechanism:         redpandav1alpha1.KafkaSASLMechanismPlain,
+		CredentialsRef:    redpandav1alpha1.NamespaceNameRef{Name: "kafka-plain", Namespace: "default"},
+		ManageACLsOnly:    true,
+		ExistingPrincipal: "external",
+	}
+	require.NoError(t, console.NewKafkaACL(c, scheme.Scheme, consoleobj, testCluster(), kafkaAdmin, console.DefaultFinalizerPrefix, log).Ensure(ctx))
+	assert.Equal(t, []*kadm.ACLBuilder{aclsOf(console.GenerateSASLUsername(consoleobj))}, admin.deleted)
+
+	// Changing the existing principal deletes the ACLs of the previous principal
+	admin.deleted = nil
+	consoleobj.Spec.Kafka.SASL.ExistingPrincipal = "other"
+	require.NoError(t, console.NewKafkaACL(c, scheme.Scheme, consoleobj, testCluster(), kafkaAdmin, console.DefaultFinalizerPrefix, log).Ensure(ctx))
+	assert.Equal(t, []*kadm.ACLBuilder{aclsOf("external")}, admin.deleted)
+	require.NotNil(t, consoleobj.Status.KafkaACLs)
+	assert.Equal(t, "other", consoleobj.Status.KafkaACLs.Principal)
+
+	// Cleanup deletes the ACLs of the applied principal even if the spec changed since
+	admin.deleted = nil
+	consoleobj.Spec.Kafka.SASL.ExistingPrincipal = "another"
+	require.NoError(t, console.NewKafkaACL(c, scheme.Scheme, consoleobj, testCluster(), kafkaAdmin, console.DefaultFinalizerPrefix, log).Cleanup(ctx))
+	assert.Equal(t, []*kadm.ACLBuilder{aclsOf("other"), aclsOf("another")}, admin.deleted)
+}
+
+func TestKafkaACL_ExternalSASLUnmanaged(t *testing.T) {
+	require.NoError(t, redpandav1alpha1.AddToScheme(scheme.Scheme))
+	ctx := context.Background()
+	log := ctrl.Log.WithName("test")
+
+	consoleobj := testConsole()
+	c := fake.NewClientBuilder().Build()
+	require.NoError(t, c.Create(ctx, consoleobj))
+
+	admin := &recordingKafkaAdmin{}
+	kafkaAdmin := func(context.Context, client.Client, *redpandav1alpha1.Cluster) (console.KafkaAdminClient, error) {
+		return admin, nil
+	}
+	require.NoError(t, console.NewKafkaACL(c, scheme.Scheme, consoleobj, testCluster(), kafkaAdmin, console.DefaultFinalizerPrefix, log).Ensure(ctx))
+
+	// Switching to existing credentials with unmanaged ACLs deletes the ACLs of the SCRAM user
+	consoleobj.Spec.Kafka.SASL = &redpandav1alpha1.KafkaSASL{
+		Mechanism:      redpandav1alpha1.KafkaSASLMechanismPlain,
+		CredentialsRef: redpandav1alpha1.NamespaceNameRef{Name: "kafka-plain", Namespace: "default"},
+	}
+	require.NoError(t, console.NewKafkaACL(c, scheme.Scheme, consoleobj, testCluster(), kafkaAdmin, console.DefaultFinalizerPrefix, log).Ensure(ctx))
+	require.Len(t, admin.deleted, 1)
+	assert.Nil(t, consoleobj.Status.KafkaACLs)
+	assert.Empty(t, consoleobj.GetFinalizers())
+}
+
+func TestGenerateConfig_ExternalSASLGSSAPI(t *testing.T) {
+	require.NoError(t, redpandav1alpha1.AddToScheme(scheme.Scheme))
+	ctx := context.Background()
+
+	consoleobj := testConsole()
+	consoleobj.Spec.Kafka.SASL = &redpandav1alpha1.KafkaSASL{
+		Mechanism:      redpandav1alpha1.KafkaSASLMechanismGSSAPI,
+		CredentialsRef: redpandav1alpha1.NamespaceNameRef{Name: "kafka-kerberos", Namespace: "default"},
+		Kerberos: &redpandav1alpha1.KafkaSASLKerberos{
+			Krb5ConfigMapRef: corev1.LocalObjectReference{Name: "krb5"},
+			Realm:            "CORP.COM",
+			ServiceName:      "kafka",
+		},
+	}
+	cluster := testCluster()
+
+	c := fake.NewClientBuilder().Build()
+	require.NoError(t, c.Create(ctx, consoleobj))
+	require.NoError(t, c.Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "kafka-kerberos", Namespace: "default"},
+		Data: map[string][]byte{
+			corev1.BasicAuthUsernameKey: []byte("console"),
+			corev1.BasicAuthPasswordKey: []byte("secret"),
+		},
+	}))
+
+	// Password authentication
+	cc := ensureConfig(t, c, consoleobj, cluster)
+	assert.True(t, cc.Kafka.SASL.Enabled)
+	assert.Equal(t, "GSSAPI", cc.Kafka.SASL.Mechanism)
+	gssapi := cc.Kafka.SASL.GSSAPIConfig
+	assert.Equal(t, "USER_AUTH", gssapi.AuthType)
+	assert.Equal(t, "/etc/krb5.conf", gssapi.KerberosConfigPath)
+	assert.Equal(t, "kafka", gssapi.ServiceName)
+	assert.Equal(t, "CORP.COM", gssapi.Realm)
+	assert.Equal(t, "console", gssapi.Username)
+	assert.Equal(t, "secret", gssapi.Password)
+	assert.Empty(t, gssapi.KeyTabPath)
+	assert.True(t, gssapi.EnableFast)
+
+	// Keytab authentication
+	consoleobj.Spec.Kafka.SASL.Kerberos.KeytabSecretRef = &corev1.LocalObjectReference{Name: "console-keytab"}
+	cc = ensureConfig(t, c, consoleobj, cluster)
+	gssapi = cc.Kafka.SASL.GSSAPIConfig
+	assert.Equal(t, "KEYTAB_AUTH", gssapi.AuthType)
+	assert.Equal(t, "/etc/console/kerberos/krb5.keytab", gssapi.KeyTabPath)
+	assert.Equal(t, "console", gssapi.Username)
+	assert.Empty(t, gssapi.Password)
+
+	// Kerberos config is rejected for other mechanisms
+	consoleobj.Spec.Kafka.SASL.Mechanism = redpandav1alpha1.KafkaSASLMechanismPlain
+	consoleobj.Status.ConfigMapRef = nil
+	cm := console.NewConfigMap(c, scheme.Scheme, consoleobj, cluster, ctrl.Log.WithName("test"))
+	assert.Error(t, cm.Ensure(ctx))
+
+	// Kerberos config is required for GSSAPI
+	consoleobj.Spec.Kafka.SASL.Mechanism = redpandav1alpha1.KafkaSASLMechanismGSSAPI
+	consoleobj.Spec.Kafka.SASL.Kerberos = nil
+	consoleobj.Status.ConfigMapRef = nil
+	cm = console.NewConfigMap(c, scheme.Scheme, consoleobj, cluster, ctrl.Log.WithName("test"))
+	assert.Error(t, cm.Ensure(ctx))
+}
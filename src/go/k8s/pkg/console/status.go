@@ -0,0 +1,51 @@
+package console
+
+import (
+	"context"
+	"fmt"
+
+	redpandav1alpha1 "github.com/redpanda-data/redpanda/src/go/k8s/apis/redpanda/v1alpha1"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// UpdateStatus updates the Console status, retrying on conflict
+// On every attempt the latest Console is fetched and the computed status is applied again
+// Only the status and resource version of the passed Console are synced with the updated object to avoid conflicts on subsequent operations
+// The spec and generation are kept, the reconcile must not observe spec changes made after it started
+func UpdateStatus(
+	ctx context.Context, cl client.Client, consoleobj *redpandav1alpha1.Console,
+) error {
+	status := consoleobj.Status.DeepCopy()
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		var latest redpandav1alpha1.Console
+		if err := cl.Get(ctx, client.ObjectKeyFromObject(consoleobj), &latest); err != nil {
+			return err
+		}
+
+		status.DeepCopyInto(&latest.Status)
+		err := cl.Status().Update(ctx, &latest)
+		if err == nil {
+			latest.Status.DeepCopyInto(&consoleobj.Status)
+			consoleobj.SetResourceVersion(latest.GetResourceVersion())
+		}
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("updating Console status: %w", err)
+	}
+	return nil
+}
+
+// ConditionError is returned by Resources if the Console can't be reconciled until the user fixes the spec or referenced resources
+// The controller sets the condition in the Console status, so the problem is visible without reading the operator logs
+type ConditionError struct {
+	Type    redpandav1alpha1.ConsoleConditionType
+	Reason  string
+	Message string
+}
+
+// Error implements error
+func (e *ConditionError) Error() string {
+	return e.Message
+}
@@ -0,0 +1,90 @@
+// Copyright 2022 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package console_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	redpandav1alpha1 "github.com/redpanda-data/redpanda/src/go/k8s/apis/redpanda/v1alpha1"
+	"github.com/redpanda-data/redpanda/src/go/k8s/pkg/console"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// conflictClient returns a conflict error for the first status updates
+type conflictClient struct {
+	client.Client
+	conflicts int
+}
+
+func (c *conflictClient) Status() client.StatusWriter {
+	return &conflictStatusWriter{StatusWriter: c.Client.Status(), c: c}
+}
+
+type conflictStatusWriter struct {
+	client.StatusWriter
+	c *conflictClient
+}
+
+func (w *conflictStatusWriter) Update(
+	ctx context.Context, obj client.Object, opts ...client.UpdateOption,
+) error {
+	if w.c.conflicts > 0 {
+		w.c.conflicts--
+		return apierrors.NewConflict(schema.GroupResource{Group: "redpanda.vectorized.io", Resource: "consoles"}, obj.GetName(), errors.New("injected conflict")) //nolint:goerr113 // test error
+	}
+	return w.StatusWriter.Update(ctx, obj, opts...)
+}
+
+func TestUpdateStatus_RetryOnConflict(t *testing.T) {
+	require.NoError(t, redpandav1alpha1.AddToScheme(scheme.Scheme))
+	ctx := context.Background()
+
+	consoleobj := testConsole()
+	c := &conflictClient{Client: fake.NewClientBuilder().Build(), conflicts: 1}
+	require.NoError(t, c.Create(ctx, consoleobj))
+
+	// Console is changed concurrently, the local copy is stale
+	concurrent := consoleobj.DeepCopy()
+	concurrent.SetLabels(map[string]string{"changed": "true"})
+	concurrent.Spec.Server.BasePath = "changed/"
+	require.NoError(t, c.Update(ctx, concurrent))
+
+	consoleobj.Status.SetCondition(
+		redpandav1alpha1.MinReplicasUnavailableConditionType,
+		corev1.ConditionTrue,
+		redpandav1alpha1.MinReplicasUnavailableReasonUnavailable,
+		"1 of 3 replicas available",
+	)
+	require.NoError(t, console.UpdateStatus(ctx, c, consoleobj))
+	assert.Zero(t, c.conflicts)
+
+	actual := &redpandav1alpha1.Console{}
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(consoleobj), actual))
+	cond := actual.Status.GetCondition(redpandav1alpha1.MinReplicasUnavailableConditionType)
+	require.NotNil(t, cond)
+	assert.Equal(t, corev1.ConditionTrue, cond.Status)
+	assert.Equal(t, "1 of 3 replicas available", cond.Message)
+	assert.Equal(t, "true", actual.GetLabels()["changed"])
+
+	// Local copy is synced with the updated Console status and resource version only
+	assert.Equal(t, actual.GetResourceVersion(), consoleobj.GetResourceVersion())
+	assert.Equal(t, "changed/", actual.Spec.Server.BasePath)
+	assert.Empty(t, consoleobj.Spec.Server.BasePath)
+	assert.Empty(t, consoleobj.GetLabels()["changed"])
+}
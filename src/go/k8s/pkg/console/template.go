@@ -0,0 +1,87 @@
+package console
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"gopkg.in/yaml.v2"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// ConfigTemplateDataKey is the required key in ConfigTemplateRef ConfigMap
+var ConfigTemplateDataKey = "config.yaml.tmpl"
+
+var configTemplateFuncs = template.FuncMap{
+	"toYaml": func(v interface{}) (string, error) {
+		out, err := yaml.Marshal(v)
+		return strings.TrimSuffix(string(out), "\n"), err
+	},
+	"join": strings.Join,
+}
+
+// renderConfigTemplate renders the template referenced by ConfigTemplateRef
+func (cm *ConfigMap) renderConfigTemplate(
+	ctx context.Context, consoleConfig *ConsoleConfig,
+) (string, error) {
+	ref := cm.consoleobj.Spec.ConfigTemplateRef
+	tmpl := corev1.ConfigMap{}
+	if err := cm.Get(ctx, client.ObjectKey{Namespace: cm.consoleobj.GetNamespace(), Name: ref.Name}, &tmpl); err != nil {
+		return "", fmt.Errorf("getting config template ConfigMap %s/%s: %w", cm.consoleobj.GetNamespace(), ref.Name, err)
+	}
+	if err := cm.adoptConfigTemplate(ctx, &tmpl); err != nil {
+		return "", err
+	}
+	text, ok := tmpl.Data[ConfigTemplateDataKey]
+	if !ok {
+		return "", fmt.Errorf("getting config template from ConfigMap %s/%s: key %s not found", tmpl.GetNamespace(), tmpl.GetName(), ConfigTemplateDataKey) //nolint:goerr113 // no need to declare new error type
+	}
+	return RenderConfigTemplate(text, consoleConfig)
+}
+
+// adoptConfigTemplate sets Console as owner of the config template ConfigMap if Deployment.AdoptOverlay is set
+// Console is not set as controller, the ConfigMap is still managed by the user
+func (cm *ConfigMap) adoptConfigTemplate(ctx context.Context, tmpl *corev1.ConfigMap) error {
+	if !cm.consoleobj.Spec.Deployment.AdoptOverlay || cm.consoleobj.Spec.DisableOwnerReferences {
+		return nil
+	}
+	for _, ref := range tmpl.GetOwnerReferences() {
+		if ref.UID == cm.consoleobj.GetUID() {
+			return nil
+		}
+	}
+	if err := controllerutil.SetOwnerReference(cm.consoleobj, tmpl, cm.scheme); err != nil {
+		return fmt.Errorf("setting owner reference on config template ConfigMap %s/%s: %w", tmpl.GetNamespace(), tmpl.GetName(), err)
+	}
+	if err := cm.Update(ctx, tmpl); err != nil {
+		return fmt.Errorf("adopting config template ConfigMap %s/%s: %w", tmpl.GetNamespace(), tmpl.GetName(), err)
+	}
+	return nil
+}
+
+// RenderConfigTemplate executes the Go template with the generated Console config as context
+// Returns an error if the template does not parse, does not execute or the result is not valid YAML
+func RenderConfigTemplate(text string, consoleConfig *ConsoleConfig) (string, error) {
+	tmpl, err := template.New(ConfigTemplateDataKey).
+		Option("missingkey=error").
+		Funcs(configTemplateFuncs).
+		Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("parsing config template: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, consoleConfig); err != nil {
+		return "", fmt.Errorf("executing config template: %w", err)
+	}
+
+	config := map[string]interface{}{}
+	if err := yaml.Unmarshal(buf.Bytes(), &config); err != nil {
+		return "", fmt.Errorf("rendered config template is not valid YAML: %w", err)
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,117 @@
+// Copyright 2022 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package console_test
+
+import (
+	"context"
+	"testing"
+
+	redpandav1alpha1 "github.com/redpanda-data/redpanda/src/go/k8s/apis/redpanda/v1alpha1"
+	"github.com/redpanda-data/redpanda/src/go/k8s/pkg/console"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const testConfigTemplate = `kafka:
+  brokers: [{{ join .Kafka.Brokers ", " }}]
+login:
+  enabled: {{ .Login.Enabled }}
+  jwtSecret: {{ .Login.JWTSecret }}
+`
+
+func TestGenerateConfig_ConfigTemplate(t *testing.T) {
+	ctx := context.Background()
+	consoleobj := testConsole()
+	consoleobj.Spec.ConfigTemplateRef = &corev1.LocalObjectReference{Name: "console-template"}
+	consoleobj.Spec.Login = &redpandav1alpha1.EnterpriseLogin{
+		Enabled:      true,
+		JWTSecretRef: redpandav1alpha1.SecretKeyRef{Name: "jwt", Namespace: "default", Key: "jwt"},
+	}
+
+	c := fake.NewClientBuilder().Build()
+	require.NoError(t, c.Create(ctx, &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "console-template", Namespace: "default"},
+		Data:       map[string]string{console.ConfigTemplateDataKey: testConfigTemplate},
+	}))
+	require.NoError(t, c.Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "jwt", Namespace: "default"},
+		Data:       map[string][]byte{"jwt": []byte("signing-key")},
+	}))
+
+	cc := ensureConfig(t, c, consoleobj, testCluster())
+	assert.Equal(t, []string{"cluster-0.cluster.default.svc.cluster.local:9092"}, cc.Kafka.Brokers)
+	assert.True(t, cc.Login.Enabled)
+	assert.Equal(t, "signing-key", cc.Login.JWTSecret)
+	// Fields not in the template are not rendered
+	assert.Empty(t, cc.MetricsNamespace)
+}
+
+func TestGenerateConfig_ConfigTemplateUnsupported(t *testing.T) {
+	consoleobj := testConsole()
+	consoleobj.Spec.ConfigTemplateRef = &corev1.LocalObjectReference{Name: "console-template"}
+
+	c := fake.NewClientBuilder().Build()
+	require.NoError(t, c.Create(context.Background(), &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "console-template", Namespace: "default"},
+		Data:       map[string]string{console.ConfigTemplateDataKey: "kafka:\n  brokers: [{{ join .Kafka.Brokers \", \" }}]\n  unknownKey: true\n"},
+	}))
+
+	// Template output is checked like the generated config
+	ensureConfigUnsupported(t, c, consoleobj, testCluster(), "unknownKey")
+}
+
+func TestGenerateConfig_ConfigTemplateAdoptOverlay(t *testing.T) {
+	ctx := context.Background()
+	consoleobj := testConsole()
+	consoleobj.Spec.ConfigTemplateRef = &corev1.LocalObjectReference{Name: "console-template"}
+	consoleobj.Spec.Deployment.AdoptOverlay = true
+
+	c := fake.NewClientBuilder().Build()
+	require.NoError(t, c.Create(ctx, &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "console-template", Namespace: "default"},
+		Data:       map[string]string{console.ConfigTemplateDataKey: "kafka: {}"},
+	}))
+
+	ensureConfig(t, c, consoleobj, testCluster())
+	tmpl := &corev1.ConfigMap{}
+	require.NoError(t, c.Get(ctx, client.ObjectKey{Namespace: "default", Name: "console-template"}, tmpl))
+	require.Len(t, tmpl.GetOwnerReferences(), 1)
+	ref := tmpl.GetOwnerReferences()[0]
+	assert.Equal(t, consoleobj.GetUID(), ref.UID)
+	assert.Equal(t, "Console", ref.Kind)
+	// The user still manages the ConfigMap
+	assert.Nil(t, ref.Controller)
+
+	// Owner reference is added once
+	ensureConfig(t, c, consoleobj, testCluster())
+	require.NoError(t, c.Get(ctx, client.ObjectKey{Namespace: "default", Name: "console-template"}, tmpl))
+	assert.Len(t, tmpl.GetOwnerReferences(), 1)
+}
+
+func TestRenderConfigTemplate_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+	}{
+		{"parse error", "kafka: {{ .Kafka.Brokers"},
+		{"execute error", "kafka: {{ .Kafka.Unknown }}"},
+		{"invalid yaml", "kafka: [{{ .Kafka.ClientID }}"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := console.RenderConfigTemplate(tt.text, &console.ConsoleConfig{})
+			assert.Error(t, err)
+		})
+	}
+}
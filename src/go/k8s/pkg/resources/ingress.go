@@ -50,6 +50,8 @@ type IngressResource struct {
 	annotations map[string]string
 	TLS         []netv1.IngressTLS
 	logger      logr.Logger
+
+	skipOwnerReference bool
 }
 
 // NewIngress creates IngressResource
@@ -74,6 +76,7 @@ func NewIngress(
 		logger.WithValues(
 			"Kind", ingressKind(),
 		),
+		false,
 	}
 }
 
@@ -105,6 +108,13 @@ func (r *IngressResource) WithTLS(issuer, secretName string) *IngressResource {
 	return r
 }
 
+// WithOwnerReference sets whether the Ingress is owned by the object
+// If not owned, the Ingress is not garbage collected when the object is deleted
+func (r *IngressResource) WithOwnerReference(set bool) *IngressResource {
+	r.skipOwnerReference = !set
+	return r
+}
+
 // Ensure will manage kubernetes Ingress for redpanda.vectorized.io custom resource
 func (r *IngressResource) Ensure(ctx context.Context) error {
 	if r.host == "" {
@@ -178,6 +188,9 @@ func (r *IngressResource) obj() (k8sclient.Object, error) {
 		},
 	}
 
+	if r.skipOwnerReference {
+		return ingress, nil
+	}
 	err = controllerutil.SetControllerReference(r.object, ingress, r.scheme)
 	if err != nil {
 		return nil, err
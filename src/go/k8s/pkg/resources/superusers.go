@@ -55,6 +55,8 @@ type SuperUsersResource struct {
 	username string
 	suffix   string
 	logger   logr.Logger
+
+	skipOwnerReference bool
 }
 
 // NewSuperUsers creates SuperUsersResource that managed super users
@@ -76,9 +78,17 @@ func NewSuperUsers(
 		logger.WithValues(
 			"Kind", ingressKind(),
 		),
+		false,
 	}
 }
 
+// WithOwnerReference sets whether the Secret is owned by the object
+// If not owned, the Secret is not garbage collected when the object is deleted
+func (r *SuperUsersResource) WithOwnerReference(set bool) *SuperUsersResource {
+	r.skipOwnerReference = !set
+	return r
+}
+
 // Ensure will manage Super users for redpanda.vectorized.io custom resource
 func (r *SuperUsersResource) Ensure(ctx context.Context) error {
 	if r == nil {
@@ -115,6 +125,9 @@ func (r *SuperUsersResource) obj() (k8sclient.Object, error) {
 		},
 	}
 
+	if r.skipOwnerReference {
+		return obj, nil
+	}
 	err = controllerutil.SetControllerReference(r.object, obj, r.scheme)
 	if err != nil {
 		return nil, err